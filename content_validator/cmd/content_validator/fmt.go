@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"content_validator/internal/data"
+	"content_validator/internal/reader"
+	"content_validator/internal/writer"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// fmtCommand is the name of the subcommand that rewrites a game genres file into the canonical layout.
+const fmtCommand = "fmt"
+
+// runFmt runs the fmt subcommand: it rewrites the JSON file into the canonical layout (see writer.Canonical), or
+// with -check only lists the entries that are not in the canonical layout and fails if there are any.
+func runFmt(arguments []string) {
+	flags := flag.NewFlagSet(fmtCommand, flag.ExitOnError)
+	check := flags.Bool("check", false,
+		"do not rewrite the JSON file, list the entries that are not in the canonical layout instead and exit with "+
+			"code 1 if there are any")
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s %s [flags] <path-to-json-file>\n", os.Args[0], fmtCommand)
+		flags.PrintDefaults()
+		fmt.Fprint(flags.Output(), exitCodesUsage)
+	}
+
+	// NOTE: the flag set exits with exitCodeUsage on invalid flags by itself.
+	_ = flags.Parse(arguments)
+
+	if flags.NArg() != expectedNumberOfArguments {
+		flags.Usage()
+		os.Exit(exitCodeUsage)
+	}
+
+	document, err := reader.ReadDocumentFromJSON(flags.Arg(0))
+
+	if err != nil {
+		exit(readErrorExitCode(err), "Failed to read game genres: %v", err)
+	}
+
+	if *check {
+		checkLayout(document)
+
+		return
+	}
+
+	canonicalContent, err := writer.EncodeGameGenres(writer.Canonical(document.Genres), true)
+
+	if err != nil {
+		exit(exitCodeIO, "Failed to format game genres: %v", err)
+	}
+
+	if bytes.Equal(document.Content, canonicalContent) {
+		return
+	}
+
+	err = writer.ReplaceFileContent(document.Path, canonicalContent)
+
+	if err != nil {
+		exit(exitCodeIO, "Failed to format game genres: %v", err)
+	}
+
+	log.Printf("Formatted %s", document.Path)
+}
+
+// checkLayout prints the layout problems of the document and exits with exitCodeValidationErrors if there are any.
+func checkLayout(document data.Document) {
+	problems, err := writer.CheckLayout(document)
+
+	if err != nil {
+		exit(exitCodeIO, "Failed to check the layout: %v", err)
+	}
+
+	for _, problem := range problems {
+		if problem.Position.IsKnown() {
+			fmt.Printf("%s:%d:%d: %s\n", document.Path, problem.Position.Line, problem.Position.Column,
+				problem.Message)
+		} else {
+			fmt.Printf("%s: %s\n", document.Path, problem.Message)
+		}
+	}
+
+	if len(problems) > 0 {
+		exit(exitCodeValidationErrors, "%s is not formatted, run `%s %s %s` to fix it", document.Path, os.Args[0],
+			fmtCommand, document.Path)
+	}
+}
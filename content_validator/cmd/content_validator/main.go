@@ -1,153 +1,298 @@
+// Command content_validator validates a game genres JSON file.
+//
+// Exit codes:
+//
+//	0: The validation passed
+//	1: The validation failed because of problems with severity error, or fmt -check found unformatted entries
+//	2: The command was used incorrectly: wrong arguments, unknown flag values or an invalid configuration
+//	3: A file could not be read or the report could not be written
+//	4: The JSON file is malformed, is not an array of genres or contains no genres
+//	5: The validation failed only because of problems with severity warning (see -fail-on)
+//
+// The "fmt" subcommand rewrites the file into the canonical layout instead, with -check it exits with code 1 if the
+// file is not in the canonical layout.
 package main
 
 import (
+	"content_validator/internal/config"
 	"content_validator/internal/data"
+	"content_validator/internal/diff"
+	"content_validator/internal/fix"
 	"content_validator/internal/reader"
+	"content_validator/internal/report"
 	"content_validator/internal/validation"
+	"content_validator/internal/writer"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
-const expectedNumberOfArguments = 2
+const expectedNumberOfArguments = 1
+
+// Exit codes of the command, see the package documentation.
+const (
+	exitCodeValidationErrors = 1
+	exitCodeUsage            = 2
+	exitCodeIO               = 3
+	exitCodeStructure        = 4
+	exitCodeWarningsOnly     = 5
+)
+
+const (
+	autoFormat   = "auto"
+	textFormat   = "text"
+	prettyFormat = "pretty"
+)
+
+const exitCodesUsage = `
+Exit codes:
+  0	the validation passed
+  1	the validation failed because of problems with severity error, or fmt -check found unformatted entries
+  2	wrong arguments, unknown flag values or an invalid configuration
+  3	a file could not be read or the report could not be written
+  4	the JSON file is malformed, is not an array of genres or contains no genres
+  5	the validation failed only because of problems with severity warning
+`
+
+var errSeverityCanNotFail = errors.New("severity can not fail the validation (expected warning or error)")
 
 func main() {
-	if len(os.Args) != expectedNumberOfArguments {
-		log.Fatalf("Usage: %s <path-to-json-file>", os.Args[0])
+	log.SetFlags(0)
+
+	if len(os.Args) > 1 && os.Args[1] == fmtCommand {
+		runFmt(os.Args[2:])
+
+		return
 	}
 
-	filePath := os.Args[1]
+	failFast := flag.Bool("fail-fast", false, "stop after the first rule that reports failing problems")
+	failOnText := flag.String("fail-on", string(validation.SeverityError),
+		"lowest severity of problems that fail the validation: warning or error")
+	configPath := flag.String("config", "",
+		"path to the configuration file (default: "+config.FileName+" next to the JSON file, if it exists)")
+	format := flag.String("format", autoFormat, "output format: "+strings.Join(report.Formats(), ", ")+
+		" or "+autoFormat+" (pretty on a terminal, text otherwise)")
+	fixMode := flag.Bool("fix", false,
+		"trim whitespace, collapse repeated spaces, lowercase, normalize to Unicode NFC and remove repeated "+
+			"alternative names in the JSON file before validating it")
+	diffMode := flag.Bool("diff", false,
+		"print the corrections of -fix as a unified diff instead of validating, without modifying the JSON file")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <path-to-json-file>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s %s [-check] <path-to-json-file>\n", os.Args[0], fmtCommand)
+		flag.PrintDefaults()
+		fmt.Fprint(flag.CommandLine.Output(), exitCodesUsage)
+	}
 
-	gameGenres, err := reader.ReadGameGenresFromJSON(filePath)
+	flag.Parse()
+
+	if flag.NArg() != expectedNumberOfArguments {
+		flag.Usage()
+		os.Exit(exitCodeUsage)
+	}
+
+	if *fixMode && *diffMode {
+		exit(exitCodeUsage, "The -fix and -diff flags can not be used together")
+	}
+
+	failOn, err := parseFailOn(*failOnText)
 
 	if err != nil {
-		log.Fatalf("Failed to read game genres: %v", err)
+		exit(exitCodeUsage, "Invalid -fail-on value: %v", err)
 	}
 
-	validateNamesNotEmpty(gameGenres)
-	validateNamesTrimmed(gameGenres)
-	validateNamesCase(gameGenres)
-	validateNamesUnique(gameGenres)
-	validateNamesCollision(gameGenres)
-}
+	if *format == autoFormat {
+		*format = textFormat
 
-func validateNamesNotEmpty(gameGenres []data.GameGenre) {
-	if !validation.ValidateNameNotEmpty(gameGenres) {
-		log.Fatal("There are game genres with empty names")
+		if report.IsTerminal(os.Stdout) {
+			*format = prettyFormat
+		}
 	}
 
-	result, invalidEntities := validation.ValidateAltNamesNotEmpty(gameGenres)
+	reporter, err := report.ForFormat(*format)
+
+	if err != nil {
+		exit(exitCodeUsage, "Invalid -format value: %v", err)
+	}
 
-	if !result {
-		log.Println("There are game genres with empty alternative names:")
+	filePath := flag.Arg(0)
 
-		for _, genre := range invalidEntities {
-			log.Println(genre)
-		}
+	validatorConfig, err := loadConfig(*configPath, filePath)
 
-		os.Exit(1)
+	if err != nil {
+		exit(configErrorExitCode(err), "Failed to load configuration: %v", err)
 	}
-}
 
-func validateNamesTrimmed(gameGenres []data.GameGenre) {
-	result, invalidEntities := validation.ValidateNameTrimmed(gameGenres)
+	rules, err := validatorConfig.Apply(validation.Rules())
 
-	if !result {
-		log.Println("There are game genres with leading or trailing whitespace in their names:")
+	if err != nil {
+		exit(exitCodeUsage, "Invalid configuration: %v", err)
+	}
 
-		for _, genre := range invalidEntities {
-			log.Println(genre)
-		}
+	suppressions, err := validatorConfig.ValidationSuppressions(validation.Rules())
 
-		os.Exit(1)
+	if err != nil {
+		exit(exitCodeUsage, "Invalid configuration: %v", err)
 	}
 
-	result, invalidEntities = validation.ValidateAltNamesTrimmed(gameGenres)
+	document, err := reader.ReadDocumentFromJSON(filePath)
 
-	if !result {
-		log.Println("There are game genres with leading or trailing whitespace in their alternative names:")
+	if err != nil {
+		exit(readErrorExitCode(err), "Failed to read game genres: %v", err)
+	}
 
-		for _, genre := range invalidEntities {
-			log.Println(genre)
+	if *diffMode {
+		err = printFixesDiff(rules, suppressions, document)
+
+		if err != nil {
+			exit(exitCodeIO, "Failed to print the corrections: %v", err)
 		}
 
-		os.Exit(1)
+		return
 	}
-}
 
-func validateNamesCase(gameGenres []data.GameGenre) {
-	result, invalidEntities := validation.ValidateNameCase(gameGenres)
+	if *fixMode {
+		document, err = fixDocument(rules, suppressions, document)
 
-	if !result {
-		log.Println("There are game genres with names that are not in lowercase:")
-
-		for _, genre := range invalidEntities {
-			log.Println(genre)
+		if err != nil {
+			exit(exitCodeIO, "Failed to fix game genres: %v", err)
 		}
+	}
+
+	findings := validation.Run(rules, document.Genres, validation.RunOptions{
+		FailFast:     *failFast,
+		FailOn:       failOn,
+		Suppressions: suppressions,
+	})
+
+	validation.LocateFindings(findings, document)
 
-		os.Exit(1)
+	validationReport := report.Report{
+		Document: document,
+		Rules:    rules,
+		Findings: findings,
+		FailOn:   failOn,
 	}
 
-	result, invalidEntities = validation.ValidateAltNamesCase(gameGenres)
+	err = reporter.Write(os.Stdout, validationReport)
 
-	if !result {
-		log.Println("There are game genres with alternative names that are not in lowercase:")
+	if err != nil {
+		exit(exitCodeIO, "Failed to write the report: %v", err)
+	}
+
+	if validationReport.Failed() {
+		exitCode := exitCodeWarningsOnly
 
-		for _, altName := range invalidEntities {
-			log.Println(altName)
+		if validation.HasFailures(findings, validation.SeverityError) {
+			exitCode = exitCodeValidationErrors
 		}
 
-		os.Exit(1)
+		exit(exitCode, "Validation failed: there are problems with severity %s or higher", failOn)
 	}
 }
 
-func validateNamesUnique(gameGenres []data.GameGenre) {
-	result, invalidEntities := validation.ValidateNameUnique(gameGenres)
+// exit logs the message and terminates the command with the exit code.
+func exit(exitCode int, format string, arguments ...any) {
+	log.Printf(format, arguments...)
+	os.Exit(exitCode)
+}
 
-	if !result {
-		log.Println("There are game genres with duplicate names:")
+func readErrorExitCode(err error) int {
+	if errors.Is(err, reader.ErrReadFile) {
+		return exitCodeIO
+	}
 
-		for _, genre := range invalidEntities {
-			log.Println(genre)
-		}
+	return exitCodeStructure
+}
 
-		os.Exit(1)
+func configErrorExitCode(err error) int {
+	if errors.Is(err, config.ErrReadFile) {
+		return exitCodeIO
 	}
 
-	result, invalidEntities = validation.ValidateAltNamesUnique(gameGenres)
+	return exitCodeUsage
+}
 
-	if !result {
-		log.Println("There are game genres with duplicate alternative names:")
+// fixDocument corrects the fixable findings in the document's file, logs the changes and returns the document read
+// back from the rewritten file. The file is not touched if there is nothing to fix.
+func fixDocument(rules []validation.Rule, suppressions []validation.Suppression,
+	document data.Document) (data.Document, error) {
+	fixedContent, changes, err := fixContent(rules, suppressions, document)
 
-		for _, genre := range invalidEntities {
-			log.Println(genre)
-		}
+	if err != nil || len(changes) == 0 {
+		return document, err
+	}
+
+	err = writer.ReplaceFileContent(document.Path, fixedContent)
+
+	if err != nil {
+		return data.Document{}, err
+	}
+
+	for _, change := range changes {
+		position := validation.FieldPosition(document.Positions, change.GenreIndex, change.Field)
 
-		os.Exit(1)
+		log.Printf("Fixed %s:%d:%d: %s", document.Path, position.Line, position.Column, change)
 	}
+
+	log.Printf("Applied %d fix(es) to %s", len(changes), document.Path)
+
+	return reader.ReadDocumentFromJSON(document.Path)
 }
 
-func validateNamesCollision(gameGenres []data.GameGenre) {
-	result, nameCollisions := validation.ValidateGenreNameNoCollisionsWithAltNames(gameGenres)
+// printFixesDiff prints the corrections of fixDocument as a unified diff against the document's file.
+func printFixesDiff(rules []validation.Rule, suppressions []validation.Suppression, document data.Document) error {
+	fixedContent, _, err := fixContent(rules, suppressions, document)
 
-	if !result {
-		log.Println("There are game genres with names that are also alternative names:")
+	if err != nil {
+		return err
+	}
 
-		for _, collision := range nameCollisions {
-			log.Printf("%s - %s", collision.CollidingGenreName, collision.GenreWithCollidingAltName)
-		}
+	path := filepath.ToSlash(document.Path)
 
-		os.Exit(1)
+	_, err = fmt.Print(diff.Unified("a/"+path, "b/"+path, string(document.Content), string(fixedContent)))
+
+	return err
+}
+
+// fixContent returns the content of the document's file with the fixable findings corrected, and the changes made.
+// Only the corrected values are rewritten, the rest of the file is kept as is.
+func fixContent(rules []validation.Rule, suppressions []validation.Suppression,
+	document data.Document) ([]byte, []fix.Change, error) {
+	_, changes := fix.Apply(rules, document.Genres, suppressions)
+
+	if len(changes) == 0 {
+		return document.Content, nil, nil
 	}
 
-	result, altNameCollisions := validation.ValidateCollidingAltNames(gameGenres)
+	fixedContent, err := writer.EditValues(document.Content, fix.Edits(changes, document.Positions))
 
-	if !result {
-		log.Println("There are game genres with alternative names that are also names:")
+	return fixedContent, changes, err
+}
 
-		for _, collision := range altNameCollisions {
-			log.Printf("%s: %s - %s", collision.AltName, collision.CollidingGenreName, collision.GenreWithCollidingAltName)
-		}
+func parseFailOn(text string) (validation.Severity, error) {
+	severity, err := validation.ParseSeverity(text)
 
-		os.Exit(1)
+	if err != nil {
+		return "", err
+	}
+
+	if severity == validation.SeverityInfo {
+		return "", fmt.Errorf("%w: %q", errSeverityCanNotFail, text)
+	}
+
+	return severity, nil
+}
+
+func loadConfig(configPath string, jsonFilePath string) (config.Config, error) {
+	if configPath != "" {
+		return config.Load(configPath)
 	}
+
+	return config.LoadForDataset(jsonFilePath)
 }
@@ -1,3 +1,8 @@
 module content_validator
 
 go 1.22.8
+
+require (
+	github.com/gertd/go-pluralize v0.2.1
+	golang.org/x/text v0.22.0
+)
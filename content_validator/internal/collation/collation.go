@@ -0,0 +1,52 @@
+package collation
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// collators reuses collators between calls, since a collator keeps internal buffers and must not be shared by
+// goroutines.
+var collators = sync.Pool{
+	New: func() any {
+		return collate.New(language.English)
+	},
+}
+
+// Compare orders two strings alphabetically: by the Unicode Collation Algorithm with the English tailoring, so
+// letters with diacritics sort next to their base letters ("bishōjo" sorts like "bishojo") and case differences
+// come last. Strings the algorithm considers equal are ordered by their bytes, so the order is total.
+//
+// Parameters:
+//
+//	a: The first string
+//	b: The second string
+//
+// Returns:
+//
+//	int: A negative number if a sorts before b, a positive number if a sorts after b, 0 if they are the same string
+//
+// Examples:
+//
+//	Compare("action", "adventure")  // returns -1
+//	Compare("bishōjo", "bishoujo")  // returns -1
+//	Compare("4x", "action")         // returns -1, digits sort before letters
+func Compare(a string, b string) int {
+	collator, _ := collators.Get().(*collate.Collator)
+	defer collators.Put(collator)
+
+	if result := collator.CompareString(a, b); result != 0 {
+		return result
+	}
+
+	return strings.Compare(a, b)
+}
+
+// Sort sorts the strings in place in the order of Compare.
+func Sort(values []string) {
+	slices.SortFunc(values, Compare)
+}
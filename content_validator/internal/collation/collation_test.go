@@ -0,0 +1,53 @@
+package collation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "same string", a: "action", b: "action", want: 0},
+		{name: "letters", a: "action", b: "adventure", want: -1},
+		{name: "digits before letters", a: "4x", b: "action", want: -1},
+		{name: "diacritics sort next to base letters", a: "bishōjo", b: "bishoujo", want: -1},
+		{name: "diacritics after base letters", a: "bishōjo", b: "bishojo", want: 1},
+		{name: "case after letters", a: "Action", b: "action", want: 1},
+		{name: "prefix first", a: "action", b: "action-adventure", want: -1},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := Compare(test.a, test.b); got != test.want {
+				runner.Errorf("Compare(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+			}
+
+			if got := Compare(test.b, test.a); got != -test.want {
+				runner.Errorf("Compare(%q, %q) = %d, want %d", test.b, test.a, got, -test.want)
+			}
+		})
+	}
+}
+
+func TestSort(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	values := []string{"visual novel", "bishoujo", "4x", "bishōjo", "Action", "action"}
+
+	Sort(values)
+
+	want := []string{"4x", "action", "Action", "bishōjo", "bishoujo", "visual novel"}
+
+	if !reflect.DeepEqual(values, want) {
+		testRunner.Errorf("got %q, want %q", values, want)
+	}
+}
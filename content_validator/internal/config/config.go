@@ -0,0 +1,279 @@
+package config
+
+import (
+	"bytes"
+	"content_validator/internal/validation"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// FileName is the name of the configuration file that is looked up next to the validated JSON file.
+const FileName = ".content-validator.json"
+
+// ErrReadFile is returned (wrapped) when the configuration file cannot be read. Other errors mean the configuration
+// itself is invalid.
+var ErrReadFile = errors.New("error reading config file")
+
+var (
+	errUnknownRule        = errors.New("unknown rule")
+	errInvalidSuppression = errors.New("invalid suppression")
+	errTrailingData       = errors.New("unexpected data after the configuration object")
+)
+
+// RuleConfig holds the configured settings of a single rule. Unset fields keep the rule's defaults.
+//
+// Fields:
+//
+//	Enabled: Whether the rule is run, nil keeps the rule's default (rules are enabled unless they are opt-in)
+//	Severity: The severity that overrides the rule's default severity, empty means no override
+//	Options: The values that override the rule's default options
+type RuleConfig struct {
+	Enabled  *bool          `json:"enabled"`
+	Severity string         `json:"severity"`
+	Options  map[string]any `json:"options"`
+}
+
+// SuppressionConfig is a configured known exception, see validation.Suppression.
+//
+// Fields:
+//
+//	Rule: The ID of the rule whose findings are suppressed, required
+//	Genre: The name of the genre whose findings are suppressed, required
+//	AltName: If not empty, only the findings about this alternative name of the genre are suppressed
+//	Reason: Why the exception is deliberate, required
+type SuppressionConfig struct {
+	Rule    string `json:"rule"`
+	Genre   string `json:"genre"`
+	AltName string `json:"altName"`
+	Reason  string `json:"reason"`
+}
+
+// Config is the content of a configuration file.
+//
+// Fields:
+//
+//	Rules: The settings of rules, keyed by rule ID
+//	Suppressions: Known exceptions whose findings are not reported
+type Config struct {
+	Rules        map[string]RuleConfig `json:"rules"`
+	Suppressions []SuppressionConfig   `json:"suppressions"`
+}
+
+// Load reads and parses a configuration file.
+//
+// Parameters:
+//
+//	configFilePath: The path to the configuration file
+//
+// Returns:
+//
+//	Config: The parsed configuration
+//	error: An error if the file cannot be read or if its structure is invalid
+//
+// Examples:
+//
+//	config, err := Load(".content-validator.json")
+//
+//	if err != nil {
+//	    log.Fatalf("Failed to load config: %v", err)
+//	}
+//
+// Errors:
+//
+//   - Returns "error reading config file: [underlying error]" if the file cannot be read
+//   - Returns "invalid config structure: [underlying error]" if the JSON cannot be parsed into a Config, including
+//     when it contains unknown fields or data after the configuration object
+//   - Returns "invalid suppression #[index]: [details]" if a suppression lacks a rule, a genre or a reason
+func Load(configFilePath string) (Config, error) {
+	content, err := os.ReadFile(configFilePath)
+
+	if err != nil {
+		return Config{}, fmt.Errorf("%w: %w", ErrReadFile, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+
+	err = decoder.Decode(&config)
+
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid config structure: %w", err)
+	}
+
+	// NOTE: Decode stops after the first value, so anything after it is only found by decoding again.
+	if !errors.Is(decoder.Decode(&json.RawMessage{}), io.EOF) {
+		return Config{}, fmt.Errorf("invalid config structure: %w", errTrailingData)
+	}
+
+	for suppressionIndex, suppression := range config.Suppressions {
+		if suppression.Rule == "" || suppression.Genre == "" || suppression.Reason == "" {
+			return Config{}, fmt.Errorf("%w #%d: rule, genre and reason are required", errInvalidSuppression,
+				suppressionIndex)
+		}
+	}
+
+	return config, nil
+}
+
+// LoadForDataset loads the configuration file that lies next to the validated JSON file, if there is one.
+//
+// Parameters:
+//
+//	jsonFilePath: The path to the validated JSON file
+//
+// Returns:
+//
+//	Config: The parsed configuration, or an empty Config if there is no configuration file next to the JSON file
+//	error: An error if the configuration file exists but cannot be read or parsed
+//
+// Examples:
+//
+//	config, err := LoadForDataset("/content/genres.json")  // loads "/content/.content-validator.json" if it exists
+func LoadForDataset(jsonFilePath string) (Config, error) {
+	config, err := Load(filepath.Join(filepath.Dir(jsonFilePath), FileName))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return Config{}, nil
+	}
+
+	return config, err
+}
+
+// Apply configures the rules according to the configuration.
+//
+// Parameters:
+//
+//	rules: The rules to configure (usually the result of validation.Rules())
+//
+// Returns:
+//
+//	[]validation.Rule: The enabled rules, in the same order, with overridden severities and options
+//	error: An error if the configuration refers to an unknown rule or contains an invalid severity or option
+//
+// Examples:
+//
+//	rules, err := config.Apply(validation.Rules())
+//
+//	if err != nil {
+//	    log.Fatalf("Invalid config: %v", err)
+//	}
+//
+// Note:
+//
+//	The given rules are not modified.
+//	Opt-in rules (see validation.Rule.Disabled) are only returned if the configuration enables them.
+func (config Config) Apply(rules []validation.Rule) ([]validation.Rule, error) {
+	knownRuleIDs := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		knownRuleIDs[rule.ID] = true
+	}
+
+	configuredRuleIDs := make([]string, 0, len(config.Rules))
+
+	for ruleID := range config.Rules {
+		configuredRuleIDs = append(configuredRuleIDs, ruleID)
+	}
+
+	slices.Sort(configuredRuleIDs)
+
+	for _, ruleID := range configuredRuleIDs {
+		if !knownRuleIDs[ruleID] {
+			return nil, fmt.Errorf("%w %q", errUnknownRule, ruleID)
+		}
+	}
+
+	configuredRules := make([]validation.Rule, 0, len(rules))
+
+	for _, rule := range rules {
+		ruleConfig, ok := config.Rules[rule.ID]
+
+		if !ok {
+			if !rule.Disabled {
+				configuredRules = append(configuredRules, rule)
+			}
+
+			continue
+		}
+
+		if ruleConfig.Enabled != nil && !*ruleConfig.Enabled {
+			continue
+		}
+
+		configuredRule, err := ruleConfig.apply(rule)
+
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.ID, err)
+		}
+
+		if !configuredRule.Disabled {
+			configuredRules = append(configuredRules, configuredRule)
+		}
+	}
+
+	return configuredRules, nil
+}
+
+func (ruleConfig RuleConfig) apply(rule validation.Rule) (validation.Rule, error) {
+	if ruleConfig.Enabled != nil {
+		rule.Disabled = !*ruleConfig.Enabled
+	}
+
+	if ruleConfig.Severity != "" {
+		severity, err := validation.ParseSeverity(ruleConfig.Severity)
+
+		if err != nil {
+			return validation.Rule{}, err
+		}
+
+		rule.DefaultSeverity = severity
+	}
+
+	options, err := rule.Options.With(ruleConfig.Options)
+
+	if err != nil {
+		return validation.Rule{}, err
+	}
+
+	rule.Options = options
+
+	return rule, nil
+}
+
+// ValidationSuppressions converts the configured suppressions into validation.Suppression values.
+//
+// Parameters:
+//
+//	rules: All known rules (usually the result of validation.Rules()), used to detect misspelled rule IDs
+//
+// Returns:
+//
+//	[]validation.Suppression: The suppressions in the order they are configured
+//	error: An error if a suppression refers to an unknown rule
+func (config Config) ValidationSuppressions(rules []validation.Rule) ([]validation.Suppression, error) {
+	suppressions := make([]validation.Suppression, 0, len(config.Suppressions))
+
+	for _, suppression := range config.Suppressions {
+		if !slices.ContainsFunc(rules, func(rule validation.Rule) bool { return rule.ID == suppression.Rule }) {
+			return nil, fmt.Errorf("suppression for genre %q: %w %q", suppression.Genre, errUnknownRule,
+				suppression.Rule)
+		}
+
+		suppressions = append(suppressions, validation.Suppression{
+			RuleID:    suppression.Rule,
+			GenreName: suppression.Genre,
+			AltName:   suppression.AltName,
+			Reason:    suppression.Reason,
+		})
+	}
+
+	return suppressions, nil
+}
@@ -0,0 +1,268 @@
+package config
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/validation"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLoadForDataset(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	directory := testRunner.TempDir()
+	jsonFilePath := filepath.Join(directory, "genres.json")
+
+	config, err := LoadForDataset(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("missing config file must not be an error, got %v", err)
+	}
+
+	if len(config.Rules) != 0 {
+		testRunner.Errorf("got rules %v, want none", config.Rules)
+	}
+
+	content := `{"rules": {"name-case": {"severity": "warning"}}}`
+
+	err = os.WriteFile(filepath.Join(directory, FileName), []byte(content), 0o600)
+
+	if err != nil {
+		testRunner.Fatal(err)
+	}
+
+	config, err = LoadForDataset(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to load config: %v", err)
+	}
+
+	if config.Rules["name-case"].Severity != "warning" {
+		testRunner.Errorf("got rules %v, want name-case with warning severity", config.Rules)
+	}
+}
+
+func TestLoadRejectsUnknownFields(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	configFilePath := filepath.Join(testRunner.TempDir(), FileName)
+
+	err := os.WriteFile(configFilePath, []byte(`{"rulez": {}}`), 0o600)
+
+	if err != nil {
+		testRunner.Fatal(err)
+	}
+
+	_, err = Load(configFilePath)
+
+	if err == nil {
+		testRunner.Error("expected an error for an unknown field")
+	}
+}
+
+func TestLoadRejectsTrailingData(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "second object", content: `{"rules": {}} {"rules": {}}`},
+		{name: "trailing text", content: `{"rules": {}} rules`},
+		{name: "trailing bracket", content: `{"rules": {}}]`},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			configFilePath := filepath.Join(runner.TempDir(), FileName)
+
+			err := os.WriteFile(configFilePath, []byte(test.content), 0o600)
+
+			if err != nil {
+				runner.Fatal(err)
+			}
+
+			_, err = Load(configFilePath)
+
+			if err == nil || !strings.HasPrefix(err.Error(), "invalid config structure") {
+				runner.Errorf("got error %v, want an invalid config structure error", err)
+			}
+		})
+	}
+
+	configFilePath := filepath.Join(testRunner.TempDir(), FileName)
+
+	err := os.WriteFile(configFilePath, []byte("{\"rules\": {}}\n\n"), 0o600)
+
+	if err != nil {
+		testRunner.Fatal(err)
+	}
+
+	_, err = Load(configFilePath)
+
+	if err != nil {
+		testRunner.Errorf("trailing whitespace must not be an error, got %v", err)
+	}
+}
+
+func TestLoadRequiresSuppressionReason(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	configFilePath := filepath.Join(testRunner.TempDir(), FileName)
+	content := `{"suppressions": [{"rule": "alt-name-collision", "genre": "action", "altName": "fighting"}]}`
+
+	err := os.WriteFile(configFilePath, []byte(content), 0o600)
+
+	if err != nil {
+		testRunner.Fatal(err)
+	}
+
+	_, err = Load(configFilePath)
+
+	if err == nil {
+		testRunner.Error("expected an error for a suppression without a reason")
+	}
+}
+
+func TestValidationSuppressions(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	config := Config{
+		Rules: nil,
+		Suppressions: []SuppressionConfig{
+			{Rule: "alt-name-collision", Genre: "action", AltName: "fighting", Reason: "shared on purpose"},
+		},
+	}
+
+	suppressions, err := config.ValidationSuppressions(validation.Rules())
+
+	if err != nil {
+		testRunner.Fatalf("failed to convert suppressions: %v", err)
+	}
+
+	want := []validation.Suppression{
+		{RuleID: "alt-name-collision", GenreName: "action", AltName: "fighting", Reason: "shared on purpose"},
+	}
+
+	if !slices.Equal(suppressions, want) {
+		testRunner.Errorf("got %v, want %v", suppressions, want)
+	}
+
+	config.Suppressions[0].Rule = "alt-names-collision"
+
+	_, err = config.ValidationSuppressions(validation.Rules())
+
+	if err == nil {
+		testRunner.Error("expected an error for a suppression of an unknown rule")
+	}
+}
+
+func TestApply(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	disabled := false
+	enabled := true
+
+	tests := []struct {
+		name         string
+		config       Config
+		genres       []data.GameGenre
+		wantFindings []string
+		wantError    bool
+	}{
+		{
+			name:         "empty config",
+			config:       Config{Rules: nil},
+			genres:       []data.GameGenre{{Name: "RPG", AltNames: []string{"CRPG"}}},
+			wantFindings: []string{"name-case error", "alt-names-case error"},
+			wantError:    false,
+		},
+		{
+			name: "disabled rule",
+			config: Config{Rules: map[string]RuleConfig{
+				"alt-names-case": {Enabled: &disabled, Severity: "", Options: nil},
+			}},
+			genres:       []data.GameGenre{{Name: "RPG", AltNames: []string{"CRPG"}}},
+			wantFindings: []string{"name-case error"},
+			wantError:    false,
+		},
+		{
+			name: "overridden severity and options",
+			config: Config{Rules: map[string]RuleConfig{
+				"name-case":      {Enabled: nil, Severity: "warning", Options: nil},
+				"alt-names-case": {Enabled: nil, Severity: "", Options: map[string]any{"exceptions": []any{"CRPG"}}},
+			}},
+			genres:       []data.GameGenre{{Name: "RPG", AltNames: []string{"CRPG"}}},
+			wantFindings: []string{"name-case warning"},
+			wantError:    false,
+		},
+		{
+			name: "enabled opt-in rule",
+			config: Config{Rules: map[string]RuleConfig{
+				"game-suffix-alt-name": {Enabled: &enabled, Severity: "", Options: nil},
+			}},
+			genres:       []data.GameGenre{{Name: "racing", AltNames: []string{"kart racing"}}},
+			wantFindings: []string{"game-suffix-alt-name info"},
+			wantError:    false,
+		},
+		{
+			name: "configured opt-in rule stays disabled",
+			config: Config{Rules: map[string]RuleConfig{
+				"game-suffix-alt-name": {Enabled: nil, Severity: "warning", Options: nil},
+			}},
+			genres:       []data.GameGenre{{Name: "racing", AltNames: []string{"kart racing"}}},
+			wantFindings: nil,
+			wantError:    false,
+		},
+		{
+			name: "unknown rule",
+			config: Config{Rules: map[string]RuleConfig{
+				"name-cases": {Enabled: nil, Severity: "warning", Options: nil},
+			}},
+			genres:       nil,
+			wantFindings: nil,
+			wantError:    true,
+		},
+		{
+			name: "invalid severity",
+			config: Config{Rules: map[string]RuleConfig{
+				"name-case": {Enabled: nil, Severity: "fatal", Options: nil},
+			}},
+			genres:       nil,
+			wantFindings: nil,
+			wantError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			rules, err := test.config.Apply(validation.Rules())
+
+			if (err != nil) != test.wantError {
+				runner.Fatalf("got error %v, want error %v", err, test.wantError)
+			}
+
+			findings := validation.Run(rules, test.genres, validation.RunOptions{
+				FailFast: false,
+				FailOn:   validation.SeverityError,
+			})
+
+			var gotFindings []string
+
+			for _, finding := range findings {
+				gotFindings = append(gotFindings, finding.RuleID+" "+string(finding.Severity))
+			}
+
+			if !slices.Equal(gotFindings, test.wantFindings) {
+				runner.Errorf("got findings %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
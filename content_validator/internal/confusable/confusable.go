@@ -0,0 +1,299 @@
+package confusable
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+// Names of the scripts that letters are compared with. Common and Inherited are shared by the characters of several
+// scripts, like digits and combining marks.
+const (
+	commonScript    = "Common"
+	inheritedScript = "Inherited"
+	latinScript     = "Latin"
+)
+
+// lookalikes maps the characters that are easily mistaken for ASCII characters to the ASCII characters they look
+// like. An empty string marks an invisible character.
+var lookalikes = map[rune]string{
+	// Apostrophes and quotation marks.
+	'\u00B4': "'",  // ACUTE ACCENT
+	'\u02BC': "'",  // MODIFIER LETTER APOSTROPHE
+	'\u2018': "'",  // LEFT SINGLE QUOTATION MARK
+	'\u2019': "'",  // RIGHT SINGLE QUOTATION MARK
+	'\u201B': "'",  // SINGLE HIGH-REVERSED-9 QUOTATION MARK
+	'\u2032': "'",  // PRIME
+	'\u201C': "\"", // LEFT DOUBLE QUOTATION MARK
+	'\u201D': "\"", // RIGHT DOUBLE QUOTATION MARK
+
+	// Hyphens and dashes.
+	'\u2010': "-", // HYPHEN
+	'\u2011': "-", // NON-BREAKING HYPHEN
+	'\u2012': "-", // FIGURE DASH
+	'\u2013': "-", // EN DASH
+	'\u2014': "-", // EM DASH
+	'\u2212': "-", // MINUS SIGN
+
+	// Spaces.
+	'\u00A0': " ", // NO-BREAK SPACE
+	'\u2002': " ", // EN SPACE
+	'\u2003': " ", // EM SPACE
+	'\u2007': " ", // FIGURE SPACE
+	'\u2009': " ", // THIN SPACE
+	'\u202F': " ", // NARROW NO-BREAK SPACE
+	'\u3000': " ", // IDEOGRAPHIC SPACE
+
+	// Invisible characters.
+	'\u00AD': "", // SOFT HYPHEN
+	'\u200B': "", // ZERO WIDTH SPACE
+	'\u200C': "", // ZERO WIDTH NON-JOINER
+	'\u200D': "", // ZERO WIDTH JOINER
+	'\u2060': "", // WORD JOINER
+	'\uFEFF': "", // ZERO WIDTH NO-BREAK SPACE
+
+	// Cyrillic letters.
+	'а': "a", 'с': "c", 'ԁ': "d", 'е': "e", 'һ': "h", 'і': "i", 'ј': "j", 'ӏ': "l", 'о': "o", 'р': "p", 'ԛ': "q",
+	'ѕ': "s", 'ԝ': "w", 'х': "x", 'у': "y",
+	'А': "A", 'В': "B", 'С': "C", 'Е': "E", 'Н': "H", 'І': "I", 'Ј': "J", 'К': "K", 'М': "M", 'О': "O", 'Р': "P",
+	'Ѕ': "S", 'Т': "T", 'Х': "X", 'У': "Y",
+
+	// Greek letters.
+	'α': "a", 'ι': "i", 'ν': "v", 'ο': "o", 'ρ': "p",
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "I", 'Κ': "K", 'Μ': "M", 'Ν': "N", 'Ο': "O", 'Ρ': "P",
+	'Τ': "T", 'Υ': "Y", 'Χ': "X",
+}
+
+// scriptNames lists the names of the Unicode scripts in alphabetical order, so the script of a character is looked
+// up in the same order every time.
+var scriptNames = sync.OnceValue(func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+})
+
+// Character is a character of a string that is easily mistaken for another one.
+//
+// Fields:
+//
+//	Rune: The character
+//	Lookalike: The ASCII characters the character looks like, empty if it is invisible
+//	Invisible: Whether the character is not displayed at all
+//	Script: The script of the character if it is a letter of another script than Latin, empty otherwise
+//	MainScript: The script of most letters of the string if Script is set and it is another one, empty otherwise
+type Character struct {
+	Rune       rune
+	Lookalike  string
+	Invisible  bool
+	Script     string
+	MainScript string
+}
+
+// String describes the character and why it is confusable, with its code point and Unicode name.
+//
+// Examples:
+//
+//	Find("beat ’em up")[0].String()  // returns `U+2019 RIGHT SINGLE QUOTATION MARK looks like "'"`
+//	Find("аction")[0].String()
+//	// returns `U+0430 CYRILLIC SMALL LETTER A is a Cyrillic letter among Latin letters and looks like "a"`
+//	Find("рс")[0].String()  // returns `U+0440 CYRILLIC SMALL LETTER ER is a Cyrillic letter that looks like "p"`
+func (character Character) String() string {
+	description := CodePoint(character.Rune)
+
+	switch {
+	case character.Invisible:
+		return description + " is invisible"
+	case character.MainScript != "":
+		return fmt.Sprintf("%s is a %s letter among %s letters and looks like %q", description, character.Script,
+			character.MainScript, character.Lookalike)
+	case character.Script != "":
+		return fmt.Sprintf("%s is a %s letter that looks like %q", description, character.Script, character.Lookalike)
+	default:
+		return fmt.Sprintf("%s looks like %q", description, character.Lookalike)
+	}
+}
+
+// CodePoint formats a character as its code point followed by its Unicode name.
+//
+// Examples:
+//
+//	CodePoint('ō')       // returns "U+014D LATIN SMALL LETTER O WITH MACRON"
+//	CodePoint('\u200B')  // returns "U+200B ZERO WIDTH SPACE"
+func CodePoint(character rune) string {
+	if name := runenames.Name(character); name != "" {
+		return fmt.Sprintf("U+%04X %s", character, name)
+	}
+
+	return fmt.Sprintf("U+%04X", character)
+}
+
+// Find lists the confusable characters of a string: look-alikes of ASCII punctuation and spaces, invisible
+// characters, and letters of another script than Latin that look like ASCII letters.
+//
+// Parameters:
+//
+//	value: The string to check
+//
+// Returns:
+//
+//	[]Character: The distinct confusable characters in the order they first occur, or nil if none found
+//
+// Examples:
+//
+//	Find("beat 'em up")  // returns nil
+//	Find("bishōjo")      // returns nil, "ō" is a Latin letter
+//	Find("beat ’em up")  // returns the right single quotation mark, which looks like "'"
+//	Find("аction")       // returns the Cyrillic "а", which looks like "a"
+//	Find("рс")           // returns the Cyrillic "р" and "с", which look like "p" and "c"
+//	Find("беат 'ем уп")  // returns nil, "б" and "т" do not look like ASCII letters
+//	Find("美少女ゲーム")       // returns nil, Katakana letters do not look like Han letters
+//
+// Note:
+//
+//	A letter of another script than Latin that looks like an ASCII letter is reported if most letters of the string
+//	are of another script, if the string has Latin letters, or if every letter of its script in the string looks
+//	like an ASCII letter. So the letters of a Cyrillic or Greek word are only valid if the word has a letter that
+//	cannot be mistaken for a Latin one. Letters without an ASCII lookalike are never reported, so names mixing
+//	scripts, like Japanese names, are valid. The main script is the script of the most letters, Latin if it is
+//	among the tied ones, or else the first of them.
+func Find(value string) []Character {
+	scripts := scriptsOf(value)
+
+	var characters []Character
+
+	for _, character := range value {
+		if slices.ContainsFunc(characters, func(found Character) bool { return found.Rune == character }) {
+			continue
+		}
+
+		if confusableCharacter, ok := classify(character, scripts); ok {
+			characters = append(characters, confusableCharacter)
+		}
+	}
+
+	return characters
+}
+
+// classify returns the character as a confusable Character, or false if it is not confusable in a string with the
+// given letter scripts. A letter is only confusable if it looks like an ASCII letter, so the letters of scripts
+// that are written together, like Han and Katakana in Japanese, are not reported.
+func classify(character rune, scripts letterScripts) (Character, bool) {
+	lookalike, hasLookalike := lookalikes[character]
+
+	if !unicode.IsLetter(character) {
+		return Character{
+			Rune:       character,
+			Lookalike:  lookalike,
+			Invisible:  hasLookalike && lookalike == "",
+			Script:     "",
+			MainScript: "",
+		}, hasLookalike
+	}
+
+	script := scriptOf(character)
+
+	if !hasLookalike || script == "" || script == latinScript {
+		return Character{}, false
+	}
+
+	mixed := script != scripts.main || scripts.counts[latinScript] > 0
+
+	if !mixed && scripts.withoutLookalike[script] {
+		return Character{}, false
+	}
+
+	mainScript := scripts.main
+
+	if mainScript == script {
+		mainScript = ""
+	}
+
+	return Character{
+		Rune:       character,
+		Lookalike:  lookalike,
+		Invisible:  false,
+		Script:     script,
+		MainScript: mainScript,
+	}, true
+}
+
+// letterScripts describes the scripts of the letters of a string.
+//
+// Fields:
+//
+//	main: The script of the most letters, Latin if it is among the tied ones, or else the first of them
+//	counts: The number of letters of each script
+//	withoutLookalike: The scripts with at least one letter in the string that does not look like an ASCII letter
+type letterScripts struct {
+	main             string
+	counts           map[string]int
+	withoutLookalike map[string]bool
+}
+
+// scriptsOf returns the scripts of the letters of the string.
+func scriptsOf(value string) letterScripts {
+	var scripts []string
+
+	result := letterScripts{main: "", counts: make(map[string]int), withoutLookalike: make(map[string]bool)}
+
+	for _, character := range value {
+		if !unicode.IsLetter(character) {
+			continue
+		}
+
+		script := scriptOf(character)
+
+		if script == "" {
+			continue
+		}
+
+		if result.counts[script] == 0 {
+			scripts = append(scripts, script)
+		}
+
+		result.counts[script]++
+
+		if _, ok := lookalikes[character]; !ok {
+			result.withoutLookalike[script] = true
+		}
+	}
+
+	for _, script := range scripts {
+		count, mainCount := result.counts[script], result.counts[result.main]
+
+		if count > mainCount || (count == mainCount && script == latinScript) {
+			result.main = script
+		}
+	}
+
+	return result
+}
+
+// scriptOf returns the name of the Unicode script of a character, or an empty string if it belongs to no script or
+// is shared by several scripts.
+func scriptOf(character rune) string {
+	if character <= unicode.MaxASCII {
+		if unicode.IsLetter(character) {
+			return latinScript
+		}
+
+		return ""
+	}
+
+	for _, name := range scriptNames() {
+		if name != commonScript && name != inheritedScript && unicode.Is(unicode.Scripts[name], character) {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,146 @@
+package confusable
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFind(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{
+			name:  "ascii",
+			value: "beat 'em up",
+			want:  nil,
+		},
+		{
+			name:  "latin letter with a diacritic",
+			value: "bishōjo",
+			want:  nil,
+		},
+		{
+			name:  "curly apostrophe",
+			value: "beat ’em up",
+			want:  []string{`U+2019 RIGHT SINGLE QUOTATION MARK looks like "'"`},
+		},
+		{
+			name:  "non-breaking space",
+			value: "beat 'em\u00A0up",
+			want:  []string{`U+00A0 NO-BREAK SPACE looks like " "`},
+		},
+		{
+			name:  "zero-width characters",
+			value: "run\u200B-n\u200B-gun\uFEFF",
+			want:  []string{"U+200B ZERO WIDTH SPACE is invisible", "U+FEFF ZERO WIDTH NO-BREAK SPACE is invisible"},
+		},
+		{
+			name:  "cyrillic letter among latin letters",
+			value: "аction",
+			want: []string{
+				`U+0430 CYRILLIC SMALL LETTER A is a Cyrillic letter among Latin letters and looks like "a"`,
+			},
+		},
+		{
+			name:  "greek letter without a lookalike among latin letters",
+			value: "λ calculus",
+			want:  nil,
+		},
+		{
+			name:  "japanese name mixing scripts",
+			value: "美少女ゲーム",
+			want:  nil,
+		},
+		{
+			name:  "latin letters in a japanese name",
+			value: "RPGツクール",
+			want:  nil,
+		},
+		{
+			name:  "cyrillic letter in a japanese name",
+			value: "美少女ゲームа",
+			want: []string{
+				`U+0430 CYRILLIC SMALL LETTER A is a Cyrillic letter among Han letters and looks like "a"`,
+			},
+		},
+		{
+			name:  "cyrillic word",
+			value: "беат 'ем уп",
+			want:  nil,
+		},
+		{
+			name:  "cyrillic letter among digits",
+			value: "4х",
+			want:  []string{`U+0445 CYRILLIC SMALL LETTER HA is a Cyrillic letter that looks like "x"`},
+		},
+		{
+			name:  "cyrillic word of lookalike letters",
+			value: "рс",
+			want: []string{
+				`U+0440 CYRILLIC SMALL LETTER ER is a Cyrillic letter that looks like "p"`,
+				`U+0441 CYRILLIC SMALL LETTER ES is a Cyrillic letter that looks like "c"`,
+			},
+		},
+		{
+			name:  "as many cyrillic letters as latin letters",
+			value: "оr",
+			want: []string{
+				`U+043E CYRILLIC SMALL LETTER O is a Cyrillic letter among Latin letters and looks like "o"`,
+			},
+		},
+		{
+			name:  "latin letter in a cyrillic word",
+			value: "бeат",
+			want:  []string{`U+0430 CYRILLIC SMALL LETTER A is a Cyrillic letter that looks like "a"`},
+		},
+		{
+			name:  "empty",
+			value: "",
+			want:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			var got []string
+
+			for _, character := range Find(test.value) {
+				got = append(got, character.String())
+			}
+
+			if !slices.Equal(got, test.want) {
+				runner.Errorf("Find(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCodePoint(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		character rune
+		want      string
+	}{
+		{character: 'a', want: "U+0061 LATIN SMALL LETTER A"},
+		{character: 'ō', want: "U+014D LATIN SMALL LETTER O WITH MACRON"},
+		{character: '\u200B', want: "U+200B ZERO WIDTH SPACE"},
+		{character: '\u0378', want: "U+0378"},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.want, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := CodePoint(test.character); got != test.want {
+				runner.Errorf("CodePoint(%q) = %q, want %q", test.character, got, test.want)
+			}
+		})
+	}
+}
@@ -4,3 +4,43 @@ type GameGenre struct {
 	Name     string   `json:"name"`
 	AltNames []string `json:"altNames"`
 }
+
+// Position is a location in a source file. Lines and columns start at 1, columns count characters (not bytes).
+// The zero value means the location is unknown.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// IsKnown reports whether the position points to an actual location.
+func (position Position) IsKnown() bool {
+	return position.Line > 0
+}
+
+// GenrePositions holds the locations of a genre and its values in the source file.
+//
+// Fields:
+//
+//	Genre: The location of the opening brace of the genre object
+//	Name: The location of the opening quote of the name value
+//	AltNames: The locations of the opening quotes of the alternative names, in the same order as GameGenre.AltNames
+type GenrePositions struct {
+	Genre    Position
+	Name     Position
+	AltNames []Position
+}
+
+// Document is a parsed game genres file.
+//
+// Fields:
+//
+//	Path: The path the file was read from
+//	Content: The raw content of the file
+//	Genres: The parsed game genres
+//	Positions: The locations of the genres in Content, in the same order as Genres
+type Document struct {
+	Path      string
+	Content   []byte
+	Genres    []GameGenre
+	Positions []GenrePositions
+}
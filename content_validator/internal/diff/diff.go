@@ -0,0 +1,253 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around every change, as in `diff -u`.
+const contextLines = 3
+
+const noNewlineMarker = "\\ No newline at end of file\n"
+
+// operation is the kind of an edit of the line-by-line transformation of one text into another.
+type operation int
+
+const (
+	operationEqual operation = iota
+	operationDelete
+	operationInsert
+)
+
+// edit is a single step of the transformation: a line kept, deleted from the old text or inserted from the new one.
+type edit struct {
+	operation operation
+	line      string
+}
+
+// Unified returns the differences between two texts in the unified diff format, as produced by `diff -u`.
+//
+// Parameters:
+//
+//	oldName: The name of the old text, written in the "---" header
+//	newName: The name of the new text, written in the "+++" header
+//	oldText: The old text
+//	newText: The new text
+//
+// Returns:
+//
+//	string: The unified diff, or an empty string if the texts are equal
+//
+// Examples:
+//
+//	Unified("a/genres.json", "b/genres.json", "[\n\t\"Action\"\n]", "[\n\t\"action\"\n]")
+//	// returns "--- a/genres.json\n+++ b/genres.json\n@@ -1,3 +1,3 @@\n [\n-\t\"Action\"\n+\t\"action\"\n ]\n"
+//	// followed by the "\ No newline at end of file" markers of both texts
+func Unified(oldName string, newName string, oldText string, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	edits := diffLines(splitLines(oldText), splitLines(newText))
+
+	var unified strings.Builder
+
+	fmt.Fprintf(&unified, "--- %s\n+++ %s\n", oldName, newName)
+
+	for _, hunk := range hunks(edits) {
+		writeHunk(&unified, edits, hunk)
+	}
+
+	return unified.String()
+}
+
+// splitLines splits the text into lines that keep their line endings, so a last line without a newline differs
+// from the same line with one.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines finds the shortest edit script that transforms the old lines into the new ones with the Myers
+// algorithm (see "An O(ND) Difference Algorithm and Its Variations").
+func diffLines(oldLines []string, newLines []string) []edit {
+	oldCount, newCount := len(oldLines), len(newLines)
+	maxDistance := oldCount + newCount
+	offset := maxDistance + 1
+
+	// NOTE: furthest[k + offset] is the furthest old line index reached on diagonal k, trace keeps a copy of the
+	// reached part of furthest for every distance so the path can be followed back.
+	furthest := make([]int, 2*maxDistance+3)
+
+	var trace [][]int
+
+	for distance := 0; distance <= maxDistance; distance++ {
+		trace = append(trace, append([]int(nil), furthest[offset-distance-1:offset+distance+2]...))
+
+		for diagonal := -distance; diagonal <= distance; diagonal += 2 {
+			var oldIndex int
+
+			if diagonal == -distance || (diagonal != distance && furthest[offset+diagonal-1] < furthest[offset+diagonal+1]) {
+				oldIndex = furthest[offset+diagonal+1]
+			} else {
+				oldIndex = furthest[offset+diagonal-1] + 1
+			}
+
+			newIndex := oldIndex - diagonal
+
+			for oldIndex < oldCount && newIndex < newCount && oldLines[oldIndex] == newLines[newIndex] {
+				oldIndex++
+				newIndex++
+			}
+
+			furthest[offset+diagonal] = oldIndex
+
+			if oldIndex >= oldCount && newIndex >= newCount {
+				return backtrack(trace, oldLines, newLines)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrack follows the path found by diffLines back from the end of both texts and returns its edits in order.
+func backtrack(trace [][]int, oldLines []string, newLines []string) []edit {
+	oldIndex, newIndex := len(oldLines), len(newLines)
+
+	var reversedEdits []edit
+
+	for distance := len(trace) - 1; distance >= 0; distance-- {
+		// NOTE: trace[distance][diagonal + distance + 1] is furthest[diagonal] before the step of this distance.
+		reached := func(diagonal int) int {
+			return trace[distance][diagonal+distance+1]
+		}
+
+		diagonal := oldIndex - newIndex
+
+		var previousDiagonal int
+
+		if diagonal == -distance || (diagonal != distance && reached(diagonal-1) < reached(diagonal+1)) {
+			previousDiagonal = diagonal + 1
+		} else {
+			previousDiagonal = diagonal - 1
+		}
+
+		previousOldIndex := reached(previousDiagonal)
+		previousNewIndex := previousOldIndex - previousDiagonal
+
+		for oldIndex > previousOldIndex && newIndex > previousNewIndex {
+			oldIndex--
+			newIndex--
+			reversedEdits = append(reversedEdits, edit{operation: operationEqual, line: oldLines[oldIndex]})
+		}
+
+		if distance == 0 {
+			break
+		}
+
+		if oldIndex == previousOldIndex {
+			newIndex--
+			reversedEdits = append(reversedEdits, edit{operation: operationInsert, line: newLines[newIndex]})
+		} else {
+			oldIndex--
+			reversedEdits = append(reversedEdits, edit{operation: operationDelete, line: oldLines[oldIndex]})
+		}
+	}
+
+	edits := make([]edit, 0, len(reversedEdits))
+
+	for editIndex := len(reversedEdits) - 1; editIndex >= 0; editIndex-- {
+		edits = append(edits, reversedEdits[editIndex])
+	}
+
+	return edits
+}
+
+// hunk is a range of edits [start, end) that is written as one "@@" section.
+type hunk struct {
+	start int
+	end   int
+}
+
+// hunks groups the changed edits with their context, merging groups whose contexts touch or overlap.
+func hunks(edits []edit) []hunk {
+	var result []hunk
+
+	for editIndex, edit := range edits {
+		if edit.operation == operationEqual {
+			continue
+		}
+
+		start := max(editIndex-contextLines, 0)
+		end := min(editIndex+contextLines+1, len(edits))
+
+		if len(result) > 0 && start <= result[len(result)-1].end {
+			result[len(result)-1].end = end
+
+			continue
+		}
+
+		result = append(result, hunk{start: start, end: end})
+	}
+
+	return result
+}
+
+func writeHunk(unified *strings.Builder, edits []edit, hunk hunk) {
+	oldStart, newStart := 1, 1
+
+	for _, edit := range edits[:hunk.start] {
+		if edit.operation != operationInsert {
+			oldStart++
+		}
+
+		if edit.operation != operationDelete {
+			newStart++
+		}
+	}
+
+	oldCount, newCount := 0, 0
+
+	for _, edit := range edits[hunk.start:hunk.end] {
+		if edit.operation != operationInsert {
+			oldCount++
+		}
+
+		if edit.operation != operationDelete {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(unified, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+
+	prefixes := map[operation]string{operationEqual: " ", operationDelete: "-", operationInsert: "+"}
+
+	for _, edit := range edits[hunk.start:hunk.end] {
+		unified.WriteString(prefixes[edit.operation])
+		unified.WriteString(edit.line)
+
+		if !strings.HasSuffix(edit.line, "\n") {
+			unified.WriteString("\n")
+			unified.WriteString(noNewlineMarker)
+		}
+	}
+}
+
+// hunkRange formats the line range of a hunk side like GNU diff: the count is omitted when it is 1, and an empty
+// range starts at the line before it.
+func hunkRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+	case 1:
+		return fmt.Sprintf("%d", start)
+	default:
+		return fmt.Sprintf("%d,%d", start, count)
+	}
+}
@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+		want    string
+	}{
+		{
+			name:    "equal texts",
+			oldText: "a\nb\n",
+			newText: "a\nb\n",
+			want:    "",
+		},
+		{
+			name:    "changed line",
+			oldText: "[\n\t\"Action\"\n]\n",
+			newText: "[\n\t\"action\"\n]\n",
+			want:    "--- a\n+++ b\n@@ -1,3 +1,3 @@\n [\n-\t\"Action\"\n+\t\"action\"\n ]\n",
+		},
+		{
+			name:    "removed line without newline at end of file",
+			oldText: "a\nb\nc",
+			newText: "a\nc",
+			want:    "--- a\n+++ b\n@@ -1,3 +1,2 @@\n a\n-b\n c\n\\ No newline at end of file\n",
+		},
+		{
+			name:    "added newline at end of file",
+			oldText: "a",
+			newText: "a\n",
+			want:    "--- a\n+++ b\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+a\n",
+		},
+		{
+			name:    "insertion into empty text",
+			oldText: "",
+			newText: "a\n",
+			want:    "--- a\n+++ b\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name:    "separate hunks",
+			oldText: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			newText: "0\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n13\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,4 +1,4 @@\n-1\n+0\n 2\n 3\n 4\n" +
+				"@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+13\n",
+		},
+		{
+			name:    "merged hunks",
+			oldText: "1\n2\n3\n4\n5\n6\n7\n",
+			newText: "0\n2\n3\n4\n5\n6\n8\n",
+			want:    "--- a\n+++ b\n@@ -1,7 +1,7 @@\n-1\n+0\n 2\n 3\n 4\n 5\n 6\n-7\n+8\n",
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := Unified("a", "b", test.oldText, test.newText); got != test.want {
+				runner.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDiffLinesIsMinimal(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	oldLines := splitLines(strings.Repeat("a\nb\nc\n", 50))
+	newLines := splitLines(strings.Repeat("a\nc\nb\n", 50))
+
+	changes := 0
+
+	for _, edit := range diffLines(oldLines, newLines) {
+		if edit.operation != operationEqual {
+			changes++
+		}
+	}
+
+	// NOTE: moving a line over its neighbour takes one deletion and one insertion per repetition.
+	const wantChanges = 100
+
+	if changes != wantChanges {
+		testRunner.Errorf("got %d changed lines, want %d", changes, wantChanges)
+	}
+}
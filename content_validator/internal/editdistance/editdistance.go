@@ -0,0 +1,107 @@
+package editdistance
+
+import (
+	"unicode/utf8"
+)
+
+// Distance computes the Damerau-Levenshtein distance between two strings: the smallest number of insertions,
+// deletions, substitutions and transpositions of two adjacent characters that turns a into b.
+//
+// Parameters:
+//
+//	a: The first string
+//	b: The second string
+//
+// Returns:
+//
+//	int: The distance, 0 if the strings are equal
+//
+// Examples:
+//
+//	Distance("text adventure", "text adventures")  // returns 1, one insertion
+//	Distance("strategy", "stratgey")               // returns 1, one transposition
+//	Distance("ca", "abc")                          // returns 2
+//
+// Note:
+//
+//	The distance is measured in runes, not bytes, so "bishōjo" is one substitution away from "bishojo".
+//	Unlike the optimal string alignment distance, a transposed pair may be edited further, which keeps the distance
+//	a metric ("ca" to "abc" is 2, not 3).
+func Distance(a string, b string) int {
+	aRunes, bRunes := []rune(a), []rune(b)
+	maxDistance := len(aRunes) + len(bRunes)
+
+	// NOTE: distances[i+1][j+1] is the distance between the first i runes of a and the first j runes of b, the extra
+	// row and column hold maxDistance so that transpositions never reach before the start of the strings.
+	distances := make([][]int, len(aRunes)+2)
+
+	for row := range distances {
+		distances[row] = make([]int, len(bRunes)+2)
+		distances[row][0] = maxDistance
+
+		if row > 0 {
+			distances[row][1] = row - 1
+		}
+	}
+
+	for column := 1; column < len(bRunes)+2; column++ {
+		distances[0][column] = maxDistance
+		distances[1][column] = column - 1
+	}
+
+	lastRowByRune := make(map[rune]int)
+
+	for aIndex := 1; aIndex <= len(aRunes); aIndex++ {
+		lastMatchingColumn := 0
+
+		for bIndex := 1; bIndex <= len(bRunes); bIndex++ {
+			transpositionRow := lastRowByRune[bRunes[bIndex-1]]
+			transpositionColumn := lastMatchingColumn
+			substitutionCost := 1
+
+			if aRunes[aIndex-1] == bRunes[bIndex-1] {
+				substitutionCost = 0
+				lastMatchingColumn = bIndex
+			}
+
+			distances[aIndex+1][bIndex+1] = min(
+				distances[aIndex][bIndex]+substitutionCost,
+				distances[aIndex+1][bIndex]+1,
+				distances[aIndex][bIndex+1]+1,
+				distances[transpositionRow][transpositionColumn]+
+					(aIndex-transpositionRow-1)+1+(bIndex-transpositionColumn-1),
+			)
+		}
+
+		lastRowByRune[aRunes[aIndex-1]] = aIndex
+	}
+
+	return distances[len(aRunes)+1][len(bRunes)+1]
+}
+
+// Normalized computes the Damerau-Levenshtein distance between two strings divided by the length of the longer one,
+// so that the distance of short and long strings can be compared with the same threshold.
+//
+// Parameters:
+//
+//	a: The first string
+//	b: The second string
+//
+// Returns:
+//
+//	float64: A number from 0 (equal strings) to 1 (nothing in common)
+//
+// Examples:
+//
+//	Normalized("text adventure", "text adventures")  // returns 0.0667 (1 edit in 15 runes)
+//	Normalized("rpg", "rts")                         // returns 0.6667 (2 edits in 3 runes)
+//	Normalized("", "")                               // returns 0
+func Normalized(a string, b string) float64 {
+	length := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+
+	if length == 0 {
+		return 0
+	}
+
+	return float64(Distance(a, b)) / float64(length)
+}
@@ -0,0 +1,72 @@
+package editdistance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "empty strings", a: "", b: "", want: 0},
+		{name: "one empty string", a: "", b: "rpg", want: 3},
+		{name: "equal strings", a: "action", b: "action", want: 0},
+		{name: "insertion", a: "text adventure", b: "text adventures", want: 1},
+		{name: "deletion", a: "wargame", b: "wrgame", want: 1},
+		{name: "substitution", a: "rpg", b: "rts", want: 2},
+		{name: "transposition", a: "strategy", b: "stratgey", want: 1},
+		{name: "edited transposition", a: "ca", b: "abc", want: 2},
+		{name: "non-ASCII runes", a: "bishōjo", b: "bishojo", want: 1},
+		{name: "different strings", a: "racing", b: "puzzle", want: 6},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := Distance(test.a, test.b); got != test.want {
+				runner.Errorf("Distance(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+			}
+
+			if got := Distance(test.b, test.a); got != test.want {
+				runner.Errorf("Distance(%q, %q) = %d, want %d", test.b, test.a, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalized(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	const tolerance = 1e-9
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "empty strings", a: "", b: "", want: 0},
+		{name: "equal strings", a: "action", b: "action", want: 0},
+		{name: "one edit in a long string", a: "text adventure", b: "text adventures", want: 1.0 / 15},
+		{name: "two edits in a short string", a: "rpg", b: "rts", want: 2.0 / 3},
+		{name: "measured in runes", a: "bishōjo", b: "bishojo", want: 1.0 / 7},
+		{name: "nothing in common", a: "", b: "rpg", want: 1},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := Normalized(test.a, test.b); math.Abs(got-test.want) > tolerance {
+				runner.Errorf("Normalized(%q, %q) = %f, want %f", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package editdistance
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// maxDeletionVariants limits the number of deletion variants generated for a string. Strings that would need more
+// are compared with every string of a similar length instead.
+const maxDeletionVariants = 1_000
+
+// roundingTolerance keeps the edit counts derived from a threshold from being rounded down by floating-point errors
+// (0.29 * 100 is 28.999999999999996).
+const roundingTolerance = 1e-9
+
+// FNV-1a parameters used to hash deletion variants.
+const (
+	fnvOffsetBasis = 14_695_981_039_346_656_037
+	fnvPrime       = 1_099_511_628_211
+)
+
+// Pair is a pair of similar strings found by SimilarPairs.
+//
+// Fields:
+//
+//	First: The index of the first string of the pair in the searched slice
+//	Second: The index of the second string of the pair in the searched slice, always greater than First
+//	Distance: The normalized distance between the strings (see Normalized)
+type Pair struct {
+	First    int
+	Second   int
+	Distance float64
+}
+
+// variant is the hash of a deletion variant of the string at valueIndex.
+type variant struct {
+	hash       uint64
+	valueIndex int
+}
+
+// SimilarPairs finds all pairs of strings whose normalized Damerau-Levenshtein distance is at most the threshold.
+//
+// Parameters:
+//
+//	values: The strings to search, they must be distinct
+//	threshold: The highest normalized distance (see Normalized) at which two strings are paired
+//
+// Returns:
+//
+//	[]Pair: The pairs of similar strings ordered by First, then Second, or nil if none found
+//
+// Examples:
+//
+//	SimilarPairs([]string{"text adventures", "racing", "text adventure"}, 0.1)
+//	// returns []Pair{{First: 0, Second: 2, Distance: 0.0667}}
+//
+// Note:
+//
+//	Comparing every string with every other string takes quadratic time, so the strings are matched through their
+//	deletion variants first: two strings within d edits of each other have a common subsequence that is at most d
+//	characters shorter than the longer string, so deleting at most d characters from each of them yields the same
+//	string. Only the strings that share a deletion variant are compared, which keeps the search close to linear for
+//	names of a typical length. Strings too long to generate their variants are compared with every string of a
+//	similar length.
+func SimilarPairs(values []string, threshold float64) []Pair {
+	if threshold <= 0 {
+		return nil
+	}
+
+	runes := make([][]rune, len(values))
+
+	for valueIndex, value := range values {
+		runes[valueIndex] = []rune(value)
+	}
+
+	var (
+		variants   []variant
+		longValues []int
+	)
+
+	for valueIndex, valueRunes := range runes {
+		hashes, ok := deletionVariantHashes(valueRunes, allowedEdits(threshold, len(valueRunes)))
+
+		if !ok {
+			longValues = append(longValues, valueIndex)
+
+			continue
+		}
+
+		for _, hash := range hashes {
+			variants = append(variants, variant{hash: hash, valueIndex: valueIndex})
+		}
+	}
+
+	candidates := append(sharedVariantCandidates(variants), similarLengthCandidates(runes, longValues, threshold)...)
+
+	slices.SortFunc(candidates, comparePairs)
+	candidates = slices.CompactFunc(candidates, func(a, b Pair) bool {
+		return comparePairs(a, b) == 0
+	})
+
+	var pairs []Pair
+
+	for _, candidate := range candidates {
+		distance := Normalized(values[candidate.First], values[candidate.Second])
+
+		if distance > 0 && distance <= threshold {
+			candidate.Distance = distance
+			pairs = append(pairs, candidate)
+		}
+	}
+
+	return pairs
+}
+
+// sharedVariantCandidates pairs the strings that have a deletion variant in common.
+func sharedVariantCandidates(variants []variant) []Pair {
+	slices.SortFunc(variants, func(a, b variant) int {
+		if a.hash != b.hash {
+			return cmp.Compare(a.hash, b.hash)
+		}
+
+		return cmp.Compare(a.valueIndex, b.valueIndex)
+	})
+
+	var candidates []Pair
+
+	for groupStart := 0; groupStart < len(variants); {
+		groupEnd := groupStart + 1
+
+		for groupEnd < len(variants) && variants[groupEnd].hash == variants[groupStart].hash {
+			groupEnd++
+		}
+
+		for first := groupStart; first < groupEnd; first++ {
+			for second := first + 1; second < groupEnd; second++ {
+				candidates = append(candidates, newCandidate(variants[first].valueIndex, variants[second].valueIndex))
+			}
+		}
+
+		groupStart = groupEnd
+	}
+
+	return candidates
+}
+
+// similarLengthCandidates pairs every long string with every string that is not longer and whose length is close
+// enough for the threshold.
+func similarLengthCandidates(runes [][]rune, longValues []int, threshold float64) []Pair {
+	if len(longValues) == 0 {
+		return nil
+	}
+
+	order := make([]int, len(runes))
+
+	for valueIndex := range order {
+		order[valueIndex] = valueIndex
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(len(runes[a]), len(runes[b]))
+	})
+
+	// NOTE: the number of deletion variants grows with the length, so the strings longer than a long string are long
+	// as well and find the pair themselves.
+	var candidates []Pair
+
+	for _, valueIndex := range longValues {
+		length := len(runes[valueIndex])
+		minLength := length - allowedEdits(threshold, length)
+
+		start, _ := slices.BinarySearchFunc(order, minLength, func(otherIndex int, target int) int {
+			return cmp.Compare(len(runes[otherIndex]), target)
+		})
+
+		for _, otherIndex := range order[start:] {
+			if len(runes[otherIndex]) > length {
+				break
+			}
+
+			if otherIndex != valueIndex {
+				candidates = append(candidates, newCandidate(valueIndex, otherIndex))
+			}
+		}
+	}
+
+	return candidates
+}
+
+func newCandidate(a int, b int) Pair {
+	return Pair{First: min(a, b), Second: max(a, b), Distance: 0}
+}
+
+func comparePairs(a Pair, b Pair) int {
+	if a.First != b.First {
+		return cmp.Compare(a.First, b.First)
+	}
+
+	return cmp.Compare(a.Second, b.Second)
+}
+
+// allowedEdits returns the number of edits the threshold allows between a string of the given length and a string
+// that is not longer.
+func allowedEdits(threshold float64, length int) int {
+	return int(math.Floor(threshold*float64(length) + roundingTolerance))
+}
+
+// deletionVariantHashes returns the distinct hashes of the strings made by deleting up to edits runes from value,
+// including value itself. It returns false if there would be more than maxDeletionVariants of them.
+//
+// Note:
+//
+//	A string shorter than the other one of a pair never needs more deletions than the edits the threshold allows for
+//	its own length: if a and b are d <= threshold*len(b) edits apart, a needs at most d-(len(b)-len(a)) deletions,
+//	which is at most threshold*len(a).
+func deletionVariantHashes(value []rune, edits int) ([]uint64, bool) {
+	if countDeletionVariants(len(value), edits) > maxDeletionVariants {
+		return nil, false
+	}
+
+	var hashes []uint64
+
+	deleted := make([]bool, len(value))
+
+	var deleteFrom func(start int, remainingEdits int)
+
+	deleteFrom = func(start int, remainingEdits int) {
+		hashes = append(hashes, hashWithout(value, deleted))
+
+		if remainingEdits == 0 {
+			return
+		}
+
+		for position := start; position < len(value); position++ {
+			deleted[position] = true
+			deleteFrom(position+1, remainingEdits-1)
+			deleted[position] = false
+		}
+	}
+
+	deleteFrom(0, edits)
+
+	slices.Sort(hashes)
+
+	return slices.Compact(hashes), true
+}
+
+// hashWithout hashes the runes of value that are not deleted, with FNV-1a applied to whole runes instead of bytes.
+func hashWithout(value []rune, deleted []bool) uint64 {
+	hash := uint64(fnvOffsetBasis)
+
+	for position, character := range value {
+		if deleted[position] {
+			continue
+		}
+
+		hash ^= uint64(character)
+		hash *= fnvPrime
+	}
+
+	return hash
+}
+
+// countDeletionVariants returns an upper bound of the number of deletion variants of a string, or a number greater
+// than maxDeletionVariants if the bound is greater.
+func countDeletionVariants(length int, edits int) int {
+	count := 0
+	combinations := 1
+
+	for deleted := 0; deleted <= min(edits, length); deleted++ {
+		count += combinations
+
+		if count > maxDeletionVariants {
+			return count
+		}
+
+		combinations = combinations * (length - deleted) / (deleted + 1)
+	}
+
+	return count
+}
@@ -0,0 +1,135 @@
+package editdistance
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSimilarPairs(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name      string
+		values    []string
+		threshold float64
+		want      []Pair
+	}{
+		{
+			name:      "no values",
+			values:    nil,
+			threshold: 0.1,
+			want:      nil,
+		},
+		{
+			name:      "plural variant",
+			values:    []string{"text adventures", "racing", "text adventure"},
+			threshold: 0.1,
+			want:      []Pair{{First: 0, Second: 2, Distance: 1.0 / 15}},
+		},
+		{
+			name:      "above the threshold",
+			values:    []string{"esports", "sports"},
+			threshold: 0.1,
+			want:      nil,
+		},
+		{
+			name:      "transposition",
+			values:    []string{"turn-based strategy", "turn-based startegy"},
+			threshold: 0.1,
+			want:      []Pair{{First: 0, Second: 1, Distance: 1.0 / 19}},
+		},
+		{
+			name:      "zero threshold",
+			values:    []string{"text adventures", "text adventure"},
+			threshold: 0,
+			want:      nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := SimilarPairs(test.values, test.threshold); !reflect.DeepEqual(got, test.want) {
+				runner.Errorf("SimilarPairs(%q, %v) = %v, want %v", test.values, test.threshold, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSimilarPairsFindsEveryPair(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	const maxLength = 4
+
+	shortValues := stringsOver("abc", maxLength)
+
+	// NOTE: at a threshold of 0.5 the strings of 10 and more runes have too many deletion variants, so both ways of
+	// finding candidates are used.
+	longValues := []string{
+		"strategy game", "strategy gmae", "stratgy game", "tactics game", "tactical game", "str", "strategy",
+		"strategist", "strategy games", "sgtrategy game",
+	}
+
+	tests := []struct {
+		name       string
+		values     []string
+		thresholds []float64
+	}{
+		{name: "short strings", values: shortValues, thresholds: []float64{0.2, 0.25, 1.0 / 3, 0.5, 0.75, 1}},
+		{name: "long strings", values: longValues, thresholds: []float64{0.1, 0.2, 0.5, 1}},
+	}
+
+	for _, test := range tests {
+		for _, threshold := range test.thresholds {
+			testRunner.Run(fmt.Sprintf("%s threshold=%v", test.name, threshold), func(runner *testing.T) {
+				runner.Parallel()
+
+				want := similarPairsOfAll(test.values, threshold)
+
+				if got := SimilarPairs(test.values, threshold); !reflect.DeepEqual(got, want) {
+					runner.Errorf("found %d pairs, want %d", len(got), len(want))
+				}
+			})
+		}
+	}
+}
+
+// similarPairsOfAll finds the similar pairs by comparing every string with every other string.
+func similarPairsOfAll(values []string, threshold float64) []Pair {
+	var pairs []Pair
+
+	for first := range values {
+		for second := first + 1; second < len(values); second++ {
+			distance := Normalized(values[first], values[second])
+
+			if distance > 0 && distance <= threshold {
+				pairs = append(pairs, Pair{First: first, Second: second, Distance: distance})
+			}
+		}
+	}
+
+	return pairs
+}
+
+// stringsOver generates every string of up to maxLength characters of the alphabet, including the empty string.
+func stringsOver(alphabet string, maxLength int) []string {
+	values := []string{""}
+	level := []string{""}
+
+	for range maxLength {
+		var nextLevel []string
+
+		for _, prefix := range level {
+			for _, character := range alphabet {
+				nextLevel = append(nextLevel, prefix+string(character))
+			}
+		}
+
+		values = append(values, nextLevel...)
+		level = nextLevel
+	}
+
+	return values
+}
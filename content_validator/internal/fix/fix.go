@@ -0,0 +1,309 @@
+package fix
+
+import (
+	"cmp"
+	"content_validator/internal/data"
+	"content_validator/internal/validation"
+	"content_validator/internal/writer"
+	"fmt"
+	"slices"
+)
+
+// maxRounds limits the number of times the fixable rules are rerun. A fix can reveal new problems (lowercasing two
+// alternative names can make them repeat each other), so fixing runs until nothing changes, but a pair of
+// conflicting fixes must not loop forever.
+const maxRounds = 10
+
+// Change is a single correction made by Apply.
+//
+// Fields:
+//
+//	RuleID: The ID of the rule whose finding was fixed
+//	GenreIndex: The index of the changed genre
+//	GenreName: The name of the genre at the time of the change
+//	Field: The changed field of the genre, as it was numbered in the original genres
+//	Before: The value before the change
+//	After: The value after the change, empty if the value was removed
+//	Removed: Whether the value was removed
+type Change struct {
+	RuleID     string
+	GenreIndex int
+	GenreName  string
+	Field      string
+	Before     string
+	After      string
+	Removed    bool
+}
+
+// String formats the change as a single human-readable line.
+//
+// Examples:
+//
+//	change := Change{
+//	    RuleID:     "name-case",
+//	    GenreIndex: 0,
+//	    GenreName:  "4X",
+//	    Field:      "name",
+//	    Before:     "4X",
+//	    After:      "4x",
+//	    Removed:    false,
+//	}
+//
+//	change.String()  // returns `genre #0 "4X", name: "4X" -> "4x" [name-case]`
+func (change Change) String() string {
+	if change.Removed {
+		return fmt.Sprintf("genre #%d %q, %s: removed %q [%s]", change.GenreIndex, change.GenreName, change.Field,
+			change.Before, change.RuleID)
+	}
+
+	return fmt.Sprintf("genre #%d %q, %s: %q -> %q [%s]", change.GenreIndex, change.GenreName, change.Field,
+		change.Before, change.After, change.RuleID)
+}
+
+// Apply corrects the findings of the rules that have a Fix function.
+//
+// Parameters:
+//
+//	rules: The configured rules, rules without a Fix function are ignored
+//	genres: The game genres to fix, they are not modified
+//	suppressions: Known exceptions, suppressed findings are not fixed
+//
+// Returns:
+//
+//	[]data.GameGenre: A fixed copy of the genres, in the same order
+//	[]Change: The applied changes in file order, the changes of the same field in the order they were made
+//
+// Examples:
+//
+//	fixedGenres, changes := Apply(validation.Rules(), []data.GameGenre{
+//	    {Name: "Action ", AltNames: []string{"fighting", "Fighting"}},
+//	}, nil)
+//
+//	// fixedGenres is []data.GameGenre{{Name: "action", AltNames: []string{"fighting"}}}
+//	// changes trim and lowercase the name, lowercase altNames[1] and then remove it as a repetition
+//
+// Note:
+//
+//	Findings that can not be fixed automatically, like collisions between genres, are left for a human.
+func Apply(rules []validation.Rule, genres []data.GameGenre,
+	suppressions []validation.Suppression) ([]data.GameGenre, []Change) {
+	fixableRules := make([]validation.Rule, 0, len(rules))
+	fixes := make(map[string]validation.FixFunc, len(rules))
+
+	for _, rule := range rules {
+		if rule.Fix != nil {
+			fixableRules = append(fixableRules, rule)
+			fixes[rule.ID] = rule.Fix
+		}
+	}
+
+	fixer := newFixer(genres)
+
+	for range maxRounds {
+		findings := validation.Run(fixableRules, fixer.genres, validation.RunOptions{
+			FailFast:     false,
+			FailOn:       validation.SeverityError,
+			Suppressions: suppressions,
+		})
+
+		if !fixer.fixRound(findings, fixes) {
+			break
+		}
+	}
+
+	slices.SortStableFunc(fixer.changes, func(a, b Change) int {
+		if a.GenreIndex != b.GenreIndex {
+			return cmp.Compare(a.GenreIndex, b.GenreIndex)
+		}
+
+		return cmp.Compare(validation.FieldOrder(a.Field), validation.FieldOrder(b.Field))
+	})
+
+	return fixer.genres, fixer.changes
+}
+
+// Edits converts the changes of Apply into corrections of the file the genres were read from, so only the changed
+// values are rewritten and the rest of the file keeps its layout.
+//
+// Parameters:
+//
+//	changes: The changes returned by Apply
+//	positions: The locations of the original genres in their file
+//
+// Returns:
+//
+//	[]writer.ValueEdit: One edit per changed value, with its last value or its removal, in file order
+//
+// Examples:
+//
+//	document, _ := reader.ReadDocumentFromJSON("genres.json")
+//	_, changes := Apply(validation.Rules(), document.Genres, nil)
+//	fixedContent, err := writer.EditValues(document.Content, Edits(changes, document.Positions))
+func Edits(changes []Change, positions []data.GenrePositions) []writer.ValueEdit {
+	edits := make([]writer.ValueEdit, 0, len(changes))
+
+	for changeIndex, change := range changes {
+		edit := writer.ValueEdit{
+			Position: validation.FieldPosition(positions, change.GenreIndex, change.Field),
+			Value:    change.After,
+			Remove:   change.Removed,
+		}
+
+		// NOTE: the changes of the same field follow each other, in the order they were made.
+		previousIndex := changeIndex - 1
+
+		if previousIndex >= 0 && changes[previousIndex].GenreIndex == change.GenreIndex &&
+			changes[previousIndex].Field == change.Field {
+			edits[len(edits)-1] = edit
+
+			continue
+		}
+
+		edits = append(edits, edit)
+	}
+
+	return edits
+}
+
+// fixer holds the state of Apply: the genres being fixed and where their alternative names originally were, so
+// changes can refer to the fields of the original file even after alternative names are removed.
+type fixer struct {
+	genres                 []data.GameGenre
+	originalAltNameIndexes [][]int
+	changes                []Change
+}
+
+func newFixer(genres []data.GameGenre) *fixer {
+	fixedGenres := make([]data.GameGenre, len(genres))
+	originalAltNameIndexes := make([][]int, len(genres))
+
+	for genreIndex, genre := range genres {
+		fixedGenres[genreIndex] = data.GameGenre{Name: genre.Name, AltNames: slices.Clone(genre.AltNames)}
+		originalAltNameIndexes[genreIndex] = make([]int, len(genre.AltNames))
+
+		for altNameIndex := range genre.AltNames {
+			originalAltNameIndexes[genreIndex][altNameIndex] = altNameIndex
+		}
+	}
+
+	return &fixer{genres: fixedGenres, originalAltNameIndexes: originalAltNameIndexes, changes: nil}
+}
+
+// removal is an alternative name that a fix removes.
+type removal struct {
+	ruleID       string
+	genreIndex   int
+	altNameIndex int
+}
+
+// fixRound fixes the findings of one run of the fixable rules and reports whether anything changed. Values are
+// replaced first and removed afterwards, since the findings refer to the alternative names by their index.
+func (fixer *fixer) fixRound(findings []validation.Finding, fixes map[string]validation.FixFunc) bool {
+	changed := false
+
+	var removals []removal
+
+	for _, finding := range findings {
+		fix, ok := fixes[finding.RuleID]
+
+		if !ok {
+			continue
+		}
+
+		value, altNameIndex, ok := fixer.value(finding)
+
+		if !ok {
+			continue
+		}
+
+		fixedValue, keep := fix(*value)
+
+		switch {
+		case !keep && altNameIndex >= 0:
+			removals = append(removals, removal{
+				ruleID:       finding.RuleID,
+				genreIndex:   finding.GenreIndex,
+				altNameIndex: altNameIndex,
+			})
+		case keep && fixedValue != *value:
+			fixer.changes = append(fixer.changes, Change{
+				RuleID:     finding.RuleID,
+				GenreIndex: finding.GenreIndex,
+				GenreName:  fixer.genres[finding.GenreIndex].Name,
+				Field:      fixer.originalField(finding.GenreIndex, altNameIndex),
+				Before:     *value,
+				After:      fixedValue,
+				Removed:    false,
+			})
+
+			*value = fixedValue
+			changed = true
+		}
+	}
+
+	// NOTE: Removing from the end keeps the indexes of the alternative names that are still to be removed.
+	slices.SortStableFunc(removals, func(a, b removal) int {
+		if a.genreIndex != b.genreIndex {
+			return cmp.Compare(a.genreIndex, b.genreIndex)
+		}
+
+		return cmp.Compare(b.altNameIndex, a.altNameIndex)
+	})
+
+	removals = slices.CompactFunc(removals, func(a, b removal) bool {
+		return a.genreIndex == b.genreIndex && a.altNameIndex == b.altNameIndex
+	})
+
+	for _, removal := range removals {
+		genre := &fixer.genres[removal.genreIndex]
+
+		fixer.changes = append(fixer.changes, Change{
+			RuleID:     removal.ruleID,
+			GenreIndex: removal.genreIndex,
+			GenreName:  genre.Name,
+			Field:      fixer.originalField(removal.genreIndex, removal.altNameIndex),
+			Before:     genre.AltNames[removal.altNameIndex],
+			After:      "",
+			Removed:    true,
+		})
+
+		genre.AltNames = slices.Delete(genre.AltNames, removal.altNameIndex, removal.altNameIndex+1)
+		fixer.originalAltNameIndexes[removal.genreIndex] = slices.Delete(
+			fixer.originalAltNameIndexes[removal.genreIndex], removal.altNameIndex, removal.altNameIndex+1)
+		changed = true
+	}
+
+	return changed
+}
+
+// value returns the value the finding is about, and the index of the alternative name or -1 if the value is the
+// genre name. It returns false if the finding is not about a value of a genre.
+func (fixer *fixer) value(finding validation.Finding) (*string, int, bool) {
+	if finding.GenreIndex < 0 || finding.GenreIndex >= len(fixer.genres) {
+		return nil, 0, false
+	}
+
+	genre := &fixer.genres[finding.GenreIndex]
+
+	if finding.Field == validation.NameField {
+		return &genre.Name, -1, true
+	}
+
+	for altNameIndex := range genre.AltNames {
+		if finding.Field == validation.AltNameField(altNameIndex) {
+			return &genre.AltNames[altNameIndex], altNameIndex, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// originalField returns the field of the original genres that the name (-1) or the alternative name of the genre
+// was read from.
+func (fixer *fixer) originalField(genreIndex int, altNameIndex int) string {
+	if altNameIndex < 0 {
+		return validation.NameField
+	}
+
+	return validation.AltNameField(fixer.originalAltNameIndexes[genreIndex][altNameIndex])
+}
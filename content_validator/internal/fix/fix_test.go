@@ -0,0 +1,225 @@
+package fix
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/diff"
+	"content_validator/internal/reader"
+	"content_validator/internal/validation"
+	"content_validator/internal/writer"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestApply(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		suppressions []validation.Suppression
+		wantGenres   []data.GameGenre
+		wantChanges  []string
+	}{
+		{
+			name:         "nothing to fix",
+			genres:       []data.GameGenre{{Name: "action", AltNames: []string{"action game"}}},
+			suppressions: nil,
+			wantGenres:   []data.GameGenre{{Name: "action", AltNames: []string{"action game"}}},
+			wantChanges:  nil,
+		},
+		{
+			name:         "trim and lowercase the name",
+			genres:       []data.GameGenre{{Name: " Action", AltNames: []string{}}},
+			suppressions: nil,
+			wantGenres:   []data.GameGenre{{Name: "action", AltNames: []string{}}},
+			wantChanges: []string{
+				`genre #0 " Action", name: " Action" -> "Action" [name-trimmed]`,
+				`genre #0 "Action", name: "Action" -> "action" [name-case]`,
+			},
+		},
+		{
+			name:         "lowercasing reveals a repetition",
+			genres:       []data.GameGenre{{Name: "racing", AltNames: []string{"Racer", "kart", "racer"}}},
+			suppressions: nil,
+			wantGenres:   []data.GameGenre{{Name: "racing", AltNames: []string{"racer", "kart"}}},
+			wantChanges: []string{
+				`genre #0 "racing", altNames[0]: "Racer" -> "racer" [alt-names-case]`,
+				`genre #0 "racing", altNames[2]: removed "racer" [alt-names-unique]`,
+			},
+		},
+		{
+			name:         "normalize but keep confusable characters",
+			genres:       []data.GameGenre{{Name: "bisho\u0304jo", AltNames: []string{"beat ’em up"}}},
+			suppressions: nil,
+			wantGenres:   []data.GameGenre{{Name: "bishōjo", AltNames: []string{"beat ’em up"}}},
+			wantChanges: []string{
+				"genre #0 \"bisho\u0304jo\", name: \"bisho\u0304jo\" -> \"bish\u014Djo\" [nfc-normalized]",
+			},
+		},
+		{
+			name:         "collapse whitespace inside values",
+			genres:       []data.GameGenre{{Name: "beat  'em up", AltNames: []string{"brawler\t game"}}},
+			suppressions: nil,
+			wantGenres:   []data.GameGenre{{Name: "beat 'em up", AltNames: []string{"brawler game"}}},
+			wantChanges: []string{
+				`genre #0 "beat  'em up", name: "beat  'em up" -> "beat 'em up" [name-single-spaced]`,
+				`genre #0 "beat 'em up", altNames[0]: "brawler\t game" -> "brawler game" [alt-names-single-spaced]`,
+			},
+		},
+		{
+			name: "several repetitions",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"a", "b", "a", "b", "c"}},
+				{Name: "racing", AltNames: []string{"kart", "kart"}},
+			},
+			suppressions: nil,
+			wantGenres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"a", "b", "c"}},
+				{Name: "racing", AltNames: []string{"kart"}},
+			},
+			wantChanges: []string{
+				`genre #0 "action", altNames[2]: removed "a" [alt-names-unique]`,
+				`genre #0 "action", altNames[3]: removed "b" [alt-names-unique]`,
+				`genre #1 "racing", altNames[1]: removed "kart" [alt-names-unique]`,
+			},
+		},
+		{
+			name:   "suppressed findings and collisions are left alone",
+			genres: []data.GameGenre{{Name: "RPG", AltNames: []string{"Rpg"}}, {Name: "rpg", AltNames: nil}},
+			suppressions: []validation.Suppression{
+				{RuleID: "name-case", GenreName: "RPG", AltName: "", Reason: "acronym"},
+			},
+			wantGenres: []data.GameGenre{{Name: "RPG", AltNames: []string{"rpg"}}, {Name: "rpg", AltNames: nil}},
+			wantChanges: []string{
+				`genre #0 "RPG", altNames[0]: "Rpg" -> "rpg" [alt-names-case]`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotGenres, changes := Apply(validation.Rules(), test.genres, test.suppressions)
+
+			if !reflect.DeepEqual(gotGenres, test.wantGenres) {
+				runner.Errorf("got genres %+v, want %+v", gotGenres, test.wantGenres)
+			}
+
+			var gotChanges []string
+
+			for _, change := range changes {
+				gotChanges = append(gotChanges, change.String())
+			}
+
+			if !reflect.DeepEqual(gotChanges, test.wantChanges) {
+				runner.Errorf("got changes %q, want %q", gotChanges, test.wantChanges)
+			}
+		})
+	}
+}
+
+func TestApplyDoesNotModifyInput(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{{Name: "Action", AltNames: []string{"Fighting", "fighting"}}}
+
+	Apply(validation.Rules(), genres, nil)
+
+	want := []data.GameGenre{{Name: "Action", AltNames: []string{"Fighting", "fighting"}}}
+
+	if !reflect.DeepEqual(genres, want) {
+		testRunner.Errorf("got genres %+v after Apply, want %+v", genres, want)
+	}
+}
+
+func TestEdits(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	positions := []data.GenrePositions{{
+		Genre:    data.Position{Line: 2, Column: 2},
+		Name:     data.Position{Line: 3, Column: 11},
+		AltNames: []data.Position{{Line: 5, Column: 4}, {Line: 6, Column: 4}, {Line: 7, Column: 4}},
+	}}
+
+	_, changes := Apply(validation.Rules(), []data.GameGenre{
+		{Name: " Action", AltNames: []string{"Fighting", "fps", "fighting"}},
+	}, nil)
+
+	want := []writer.ValueEdit{
+		{Position: data.Position{Line: 3, Column: 11}, Value: "action", Remove: false},
+		{Position: data.Position{Line: 5, Column: 4}, Value: "fighting", Remove: false},
+		{Position: data.Position{Line: 7, Column: 4}, Value: "", Remove: true},
+	}
+
+	if got := Edits(changes, positions); !reflect.DeepEqual(got, want) {
+		testRunner.Errorf("Edits() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEditsKeepLayout(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	content := strings.Join([]string{
+		`[`,
+		`  {"name": "shooter", "altNames": ["FPS", "tps"]},`,
+		`  {`,
+		`    "name": "Action",`,
+		`    "altNames": [`,
+		`      "fighting",`,
+		`      "brawler",`,
+		`      "Fighting"`,
+		`    ]`,
+		`  },`,
+		`  {"name": "racing"},`,
+		`  {"name": "arcade", "altNames": []}`,
+		`]`,
+	}, "\n")
+
+	wantChangedLines := []string{
+		`-  {"name": "shooter", "altNames": ["FPS", "tps"]},`,
+		`+  {"name": "shooter", "altNames": ["fps", "tps"]},`,
+		`-    "name": "Action",`,
+		`+    "name": "action",`,
+		`-      "brawler",`,
+		`-      "Fighting"`,
+		`+      "brawler"`,
+	}
+
+	jsonFilePath := filepath.Join(testRunner.TempDir(), "genres.json")
+
+	err := os.WriteFile(jsonFilePath, []byte(content), 0o600)
+
+	if err != nil {
+		testRunner.Fatalf("failed to write test file: %v", err)
+	}
+
+	document, err := reader.ReadDocumentFromJSON(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to read test file: %v", err)
+	}
+
+	_, changes := Apply(validation.Rules(), document.Genres, nil)
+	fixedContent, err := writer.EditValues(document.Content, Edits(changes, document.Positions))
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	var gotChangedLines []string
+
+	for _, line := range strings.Split(diff.Unified("a", "b", content, string(fixedContent)), "\n") {
+		if (strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+")) &&
+			!strings.HasPrefix(line, "--- ") && !strings.HasPrefix(line, "+++ ") {
+			gotChangedLines = append(gotChangedLines, line)
+		}
+	}
+
+	if !reflect.DeepEqual(gotChangedLines, wantChangedLines) {
+		testRunner.Errorf("got changed lines %q, want %q", gotChangedLines, wantChangedLines)
+	}
+}
@@ -0,0 +1,66 @@
+package inflection
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// client is the English inflection engine. It is built on the first use, since it compiles a few hundred regular
+// expressions, and it is safe for concurrent use afterwards because it is never modified.
+var client = sync.OnceValue(pluralize.NewClient)
+
+// IsPlural checks if an English word is in the plural form.
+//
+// Parameters:
+//
+//	word: The word to check
+//
+// Returns:
+//
+//	bool: true if the word is a plural, false if it is a singular or a word whose plural is the same as its singular
+//
+// Examples:
+//
+//	IsPlural("adventures")  // returns true
+//	IsPlural("adventure")   // returns false
+//	IsPlural("series")      // returns false, "series" is both singular and plural
+func IsPlural(word string) bool {
+	return client().IsPlural(word) && !client().IsSingular(word)
+}
+
+// Singular returns the singular form of an English word, or the word itself if it is not a plural.
+//
+// Examples:
+//
+//	Singular("adventures")  // returns "adventure"
+//	Singular("strategies")  // returns "strategy"
+//	Singular("strategy")    // returns "strategy"
+func Singular(word string) string {
+	return client().Singular(word)
+}
+
+// LastWord returns the last space-separated word of a phrase, which is the noun that gets inflected in names such as
+// "text adventures".
+//
+// Examples:
+//
+//	LastWord("girls' video games")  // returns "games"
+//	LastWord("run-n-gun")           // returns "run-n-gun"
+func LastWord(phrase string) string {
+	return phrase[strings.LastIndexByte(phrase, ' ')+1:]
+}
+
+// SingularPhrase returns the phrase with its last word in the singular form, so that the singular and the plural form
+// of a phrase have the same SingularPhrase.
+//
+// Examples:
+//
+//	SingularPhrase("text adventures")  // returns "text adventure"
+//	SingularPhrase("text adventure")   // returns "text adventure"
+func SingularPhrase(phrase string) string {
+	lastWordStart := strings.LastIndexByte(phrase, ' ') + 1
+
+	return phrase[:lastWordStart] + Singular(phrase[lastWordStart:])
+}
@@ -0,0 +1,81 @@
+package inflection
+
+import (
+	"testing"
+)
+
+func TestIsPlural(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{word: "adventures", want: true},
+		{word: "games", want: true},
+		{word: "strategies", want: true},
+		{word: "adventure", want: false},
+		{word: "strategy", want: false},
+		{word: "series", want: false},
+		{word: "", want: false},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.word, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := IsPlural(test.word); got != test.want {
+				runner.Errorf("IsPlural(%q) = %v, want %v", test.word, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSingularPhrase(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		phrase string
+		want   string
+	}{
+		{phrase: "text adventures", want: "text adventure"},
+		{phrase: "text adventure", want: "text adventure"},
+		{phrase: "girls' video games", want: "girls' video game"},
+		{phrase: "run-n-guns", want: "run-n-gun"},
+		{phrase: "strategies", want: "strategy"},
+		{phrase: "", want: ""},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.phrase, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := SingularPhrase(test.phrase); got != test.want {
+				runner.Errorf("SingularPhrase(%q) = %q, want %q", test.phrase, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLastWord(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		phrase string
+		want   string
+	}{
+		{phrase: "girls' video games", want: "games"},
+		{phrase: "run-n-gun", want: "run-n-gun"},
+		{phrase: "", want: ""},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.phrase, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := LastWord(test.phrase); got != test.want {
+				runner.Errorf("LastWord(%q) = %q, want %q", test.phrase, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package reader
+
+import (
+	"bytes"
+	"content_validator/internal/data"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var errUnexpectedToken = errors.New("unexpected token")
+
+// positionScanner walks the JSON tokens of a game genres file and records where the genres and their values are.
+type positionScanner struct {
+	content    []byte
+	decoder    *json.Decoder
+	lineStarts []int
+}
+
+func newPositionScanner(content []byte) *positionScanner {
+	lineStarts := []int{0}
+
+	for offset, character := range content {
+		if character == '\n' {
+			lineStarts = append(lineStarts, offset+1)
+		}
+	}
+
+	return &positionScanner{
+		content:    content,
+		decoder:    json.NewDecoder(bytes.NewReader(content)),
+		lineStarts: lineStarts,
+	}
+}
+
+// scanGenrePositions finds the positions of the genres in content. The content must already be known to be a valid
+// array of genres, since the function only reports errors, it does not explain them.
+func scanGenrePositions(content []byte) ([]data.GenrePositions, error) {
+	scanner := newPositionScanner(content)
+
+	_, err := scanner.expectDelimiter('[')
+
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []data.GenrePositions
+
+	for scanner.decoder.More() {
+		genrePositions, err := scanner.scanGenre()
+
+		if err != nil {
+			return nil, err
+		}
+
+		positions = append(positions, genrePositions)
+	}
+
+	_, err = scanner.expectDelimiter(']')
+
+	if err != nil {
+		return nil, err
+	}
+
+	return positions, nil
+}
+
+func (scanner *positionScanner) scanGenre() (data.GenrePositions, error) {
+	var genrePositions data.GenrePositions
+
+	genrePosition, err := scanner.expectDelimiter('{')
+
+	if err != nil {
+		return genrePositions, err
+	}
+
+	genrePositions.Genre = genrePosition
+
+	for scanner.decoder.More() {
+		key, _, err := scanner.next()
+
+		if err != nil {
+			return genrePositions, err
+		}
+
+		// NOTE: json.Unmarshal matches keys case-insensitively, so the scanner does the same.
+		switch keyText, _ := key.(string); {
+		case strings.EqualFold(keyText, "name"):
+			_, genrePositions.Name, err = scanner.next()
+		case strings.EqualFold(keyText, "altNames"):
+			genrePositions.AltNames, err = scanner.scanAltNames()
+		default:
+			err = scanner.skipValue()
+		}
+
+		if err != nil {
+			return genrePositions, err
+		}
+	}
+
+	_, err = scanner.expectDelimiter('}')
+
+	return genrePositions, err
+}
+
+func (scanner *positionScanner) scanAltNames() ([]data.Position, error) {
+	token, _, err := scanner.next()
+
+	if err != nil || token == nil {
+		return nil, err
+	}
+
+	if token != json.Delim('[') {
+		return nil, fmt.Errorf("%w %v", errUnexpectedToken, token)
+	}
+
+	var positions []data.Position
+
+	for scanner.decoder.More() {
+		_, position, err := scanner.next()
+
+		if err != nil {
+			return nil, err
+		}
+
+		positions = append(positions, position)
+	}
+
+	_, err = scanner.expectDelimiter(']')
+
+	return positions, err
+}
+
+func (scanner *positionScanner) skipValue() error {
+	depth := 0
+
+	for {
+		token, _, err := scanner.next()
+
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('['), json.Delim('{'):
+			depth++
+		case json.Delim(']'), json.Delim('}'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func (scanner *positionScanner) expectDelimiter(delimiter json.Delim) (data.Position, error) {
+	token, position, err := scanner.next()
+
+	if err != nil {
+		return position, err
+	}
+
+	if token != delimiter {
+		return position, fmt.Errorf("%w %v at %d:%d, expected %v", errUnexpectedToken, token, position.Line,
+			position.Column, delimiter)
+	}
+
+	return position, nil
+}
+
+// next reads the next token and returns it with the position of its first character.
+func (scanner *positionScanner) next() (json.Token, data.Position, error) {
+	offset := int(scanner.decoder.InputOffset())
+
+	// NOTE: InputOffset points right after the previous token, the separators between tokens are skipped by Token.
+	for offset < len(scanner.content) && strings.IndexByte(" \t\r\n,:", scanner.content[offset]) >= 0 {
+		offset++
+	}
+
+	token, err := scanner.decoder.Token()
+
+	if err != nil {
+		return nil, data.Position{}, fmt.Errorf("error scanning positions: %w", err)
+	}
+
+	return token, scanner.position(offset), nil
+}
+
+func (scanner *positionScanner) position(offset int) data.Position {
+	lineIndex := sort.Search(len(scanner.lineStarts), func(index int) bool {
+		return scanner.lineStarts[index] > offset
+	}) - 1
+
+	lineStart := scanner.lineStarts[lineIndex]
+
+	return data.Position{
+		Line:   lineIndex + 1,
+		Column: utf8.RuneCount(scanner.content[lineStart:offset]) + 1,
+	}
+}
@@ -9,7 +9,16 @@ import (
 	"os"
 )
 
-var errNoGameGenresFound = errors.New("no game genres found in JSON")
+var (
+	// ErrReadFile is returned (wrapped) when the JSON file cannot be read.
+	ErrReadFile = errors.New("error reading file")
+
+	// ErrInvalidStructure is returned (wrapped) when the JSON file is malformed or is not an array of genres.
+	ErrInvalidStructure = errors.New("invalid structure")
+
+	// ErrNoGameGenresFound is returned when the JSON file contains an empty array.
+	ErrNoGameGenresFound = errors.New("no game genres found in JSON")
+)
 
 // ReadGameGenresFromJSON reads and parses game genres from a JSON file.
 //
@@ -32,19 +41,58 @@ var errNoGameGenresFound = errors.New("no game genres found in JSON")
 //
 // Errors:
 //
+//	See ReadDocumentFromJSON.
+func ReadGameGenresFromJSON(jsonFilePath string) ([]data.GameGenre, error) {
+	document, err := ReadDocumentFromJSON(jsonFilePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return document.Genres, nil
+}
+
+// ReadDocumentFromJSON reads and parses game genres from a JSON file, along with the source positions of the genres
+// and their values.
+//
+// Parameters:
+//
+//	jsonFilePath: The path to the JSON file containing game genre data
+//
+// Returns:
+//
+//	data.Document: The parsed file, with one GenrePositions entry for every genre
+//	error: An error if the file cannot be read or if the JSON structure is invalid
+//
+// Examples:
+//
+//	document, err := ReadDocumentFromJSON("game_genres.json")
+//
+//	if err != nil {
+//	    log.Fatalf("Failed to read game genres: %v", err)
+//	}
+//
+//	document.Positions[0].Name  // returns data.Position{Line: 3, Column: 11} for a tab-indented file
+//
+// Errors:
+//
 //   - Returns "error reading file: [underlying error]" if the file cannot be read
 //   - Returns "invalid structure: [underlying error]" if the JSON cannot be parsed into GameGenre objects
+//   - Returns "no game genres found in JSON" if the JSON is an empty array
+//
+// The errors wrap ErrReadFile, ErrInvalidStructure and ErrNoGameGenresFound respectively, so they can be told apart
+// with errors.Is.
 //
 // Note:
 //
 //	The function expects the JSON file to contain an array of objects that can be
 //	unmarshaled into the data.GameGenre struct. Make sure the JSON structure
 //	matches the GameGenre definition.
-func ReadGameGenresFromJSON(jsonFilePath string) ([]data.GameGenre, error) {
+func ReadDocumentFromJSON(jsonFilePath string) (data.Document, error) {
 	content, err := os.ReadFile(jsonFilePath)
 
 	if err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
+		return data.Document{}, fmt.Errorf("%w: %w", ErrReadFile, err)
 	}
 
 	var gameGenres []data.GameGenre
@@ -52,12 +100,23 @@ func ReadGameGenresFromJSON(jsonFilePath string) ([]data.GameGenre, error) {
 	err = json.Unmarshal(content, &gameGenres)
 
 	if err != nil {
-		return nil, fmt.Errorf("invalid structure: %w", err)
+		return data.Document{}, fmt.Errorf("%w: %w", ErrInvalidStructure, err)
 	}
 
 	if len(gameGenres) == 0 {
-		return nil, errNoGameGenresFound
+		return data.Document{}, ErrNoGameGenresFound
+	}
+
+	positions, err := scanGenrePositions(content)
+
+	if err != nil {
+		return data.Document{}, fmt.Errorf("%w: %w", ErrInvalidStructure, err)
 	}
 
-	return gameGenres, nil
+	return data.Document{
+		Path:      jsonFilePath,
+		Content:   content,
+		Genres:    gameGenres,
+		Positions: positions,
+	}, nil
 }
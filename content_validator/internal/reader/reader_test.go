@@ -0,0 +1,125 @@
+package reader
+
+import (
+	"content_validator/internal/data"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadDocumentFromJSON(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name          string
+		content       string
+		wantPositions []data.GenrePositions
+		wantErr       error
+	}{
+		{
+			name: "tab indented file",
+			content: "[\n" +
+				"\t{\n" +
+				"\t\t\"name\": \"action\",\n" +
+				"\t\t\"altNames\": [\n" +
+				"\t\t\t\"action game\",\n" +
+				"\t\t\t\"fighting\"\n" +
+				"\t\t]\n" +
+				"\t},\n" +
+				"\t{\n" +
+				"\t\t\"name\": \"racing\",\n" +
+				"\t\t\"altNames\": []\n" +
+				"\t}\n" +
+				"]",
+			wantPositions: []data.GenrePositions{
+				{
+					Genre:    data.Position{Line: 2, Column: 2},
+					Name:     data.Position{Line: 3, Column: 11},
+					AltNames: []data.Position{{Line: 5, Column: 4}, {Line: 6, Column: 4}},
+				},
+				{
+					Genre:    data.Position{Line: 9, Column: 2},
+					Name:     data.Position{Line: 10, Column: 11},
+					AltNames: nil,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "columns count characters",
+			content: `[{"name": "jeu d'été", "altNames": ["été", "jeu"]}]`,
+			wantPositions: []data.GenrePositions{
+				{
+					Genre:    data.Position{Line: 1, Column: 2},
+					Name:     data.Position{Line: 1, Column: 11},
+					AltNames: []data.Position{{Line: 1, Column: 37}, {Line: 1, Column: 44}},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "unknown keys, null alt names and different key case",
+			content: `[{"id": {"nested": [1, 2]}, "Name": "action", "altNames": null}]`,
+			wantPositions: []data.GenrePositions{
+				{
+					Genre:    data.Position{Line: 1, Column: 2},
+					Name:     data.Position{Line: 1, Column: 37},
+					AltNames: nil,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:          "invalid structure",
+			content:       `{"name": "action"}`,
+			wantPositions: nil,
+			wantErr:       ErrInvalidStructure,
+		},
+		{
+			name:          "no genres",
+			content:       `[]`,
+			wantPositions: nil,
+			wantErr:       ErrNoGameGenresFound,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			jsonFilePath := filepath.Join(runner.TempDir(), "genres.json")
+
+			err := os.WriteFile(jsonFilePath, []byte(test.content), 0o600)
+
+			if err != nil {
+				runner.Fatalf("failed to write test file: %v", err)
+			}
+
+			document, err := ReadDocumentFromJSON(jsonFilePath)
+
+			if !errors.Is(err, test.wantErr) {
+				runner.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+
+			if !reflect.DeepEqual(document.Positions, test.wantPositions) {
+				runner.Errorf("got positions %+v, want %+v", document.Positions, test.wantPositions)
+			}
+
+			if err == nil && document.Path != jsonFilePath {
+				runner.Errorf("got path %q, want %q", document.Path, jsonFilePath)
+			}
+		})
+	}
+}
+
+func TestReadDocumentFromJSONMissingFile(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	_, err := ReadDocumentFromJSON(filepath.Join(testRunner.TempDir(), "missing.json"))
+
+	if !errors.Is(err, os.ErrNotExist) || !errors.Is(err, ErrReadFile) {
+		testRunner.Errorf("got error %v, want %v wrapped in %v", err, os.ErrNotExist, ErrReadFile)
+	}
+}
@@ -0,0 +1,129 @@
+package report
+
+import (
+	"content_validator/internal/validation"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StepSummaryEnvironmentVariable is the environment variable in which GitHub Actions passes the path of the file
+// that receives the Markdown summary of the current step.
+const StepSummaryEnvironmentVariable = "GITHUB_STEP_SUMMARY"
+
+// stepSummaryFileMode is used only if the step summary file does not exist yet, GitHub Actions creates it beforehand.
+const stepSummaryFileMode = 0o644
+
+// githubCommands maps severities to the GitHub Actions workflow commands that create annotations.
+var githubCommands = map[validation.Severity]string{
+	validation.SeverityError:   "error",
+	validation.SeverityWarning: "warning",
+	validation.SeverityInfo:    "notice",
+}
+
+var (
+	githubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+	markdownCellEscaper   = strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ")
+)
+
+// githubReporter writes the findings as GitHub Actions workflow commands, so they are shown as annotations on the
+// pull request diff. If the step summary environment variable is set, it also appends a Markdown summary to the file
+// it names.
+//
+// Fields:
+//
+//	getenv: Looks up environment variables, os.Getenv outside of tests
+type githubReporter struct {
+	getenv func(key string) string
+}
+
+func (reporter githubReporter) Write(writer io.Writer, report Report) error {
+	for _, finding := range report.Findings {
+		_, err := fmt.Fprintln(writer, githubAnnotation(report.Document.Path, finding))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	stepSummaryPath := reporter.getenv(StepSummaryEnvironmentVariable)
+
+	if stepSummaryPath == "" {
+		return nil
+	}
+
+	stepSummaryFile, err := os.OpenFile(stepSummaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, stepSummaryFileMode)
+
+	if err != nil {
+		return fmt.Errorf("error opening step summary file: %w", err)
+	}
+
+	err = writeStepSummary(stepSummaryFile, report)
+
+	if err != nil {
+		_ = stepSummaryFile.Close()
+
+		return fmt.Errorf("error writing step summary: %w", err)
+	}
+
+	return stepSummaryFile.Close()
+}
+
+// githubAnnotation formats the finding as a workflow command, e.g.
+// `::error file=genres.json,line=3,col=11,title=name-case::genre #0 "4X": genre name is not in lowercase`.
+func githubAnnotation(file string, finding validation.Finding) string {
+	properties := []string{"file=" + githubPropertyEscaper.Replace(filepath.ToSlash(file))}
+
+	if finding.Position.IsKnown() {
+		properties = append(properties,
+			fmt.Sprintf("line=%d", finding.Position.Line),
+			fmt.Sprintf("col=%d", finding.Position.Column))
+	}
+
+	properties = append(properties, "title="+githubPropertyEscaper.Replace(finding.RuleID))
+
+	return fmt.Sprintf("::%s %s::%s", githubCommands[finding.Severity], strings.Join(properties, ","),
+		githubDataEscaper.Replace(finding.Subject()+": "+finding.Message))
+}
+
+// writeStepSummary writes the Markdown summary of the report: the result, the number of findings of each severity
+// and a table of the findings.
+func writeStepSummary(writer io.Writer, report Report) error {
+	var summary strings.Builder
+
+	fmt.Fprintf(&summary, "## Validation of `%s`\n\n", report.Document.Path)
+
+	counts := validation.CountFindings(report.Findings)
+	result := ":white_check_mark: Passed"
+
+	if report.Failed() {
+		result = ":x: Failed"
+	}
+
+	fmt.Fprintf(&summary, "%s: %d error(s), %d warning(s), %d info(s).\n", result,
+		counts[validation.SeverityError], counts[validation.SeverityWarning], counts[validation.SeverityInfo])
+
+	if len(report.Findings) > 0 {
+		summary.WriteString("\n| Location | Severity | Rule | Finding |\n| --- | --- | --- | --- |\n")
+
+		for _, finding := range report.Findings {
+			location := "-"
+
+			if finding.Position.IsKnown() {
+				location = fmt.Sprintf("%d:%d", finding.Position.Line, finding.Position.Column)
+			}
+
+			fmt.Fprintf(&summary, "| %s | %s | `%s` | %s |\n", location, finding.Severity, finding.RuleID,
+				markdownCellEscaper.Replace(finding.Subject()+": "+finding.Message))
+		}
+	}
+
+	summary.WriteString("\n")
+
+	_, err := io.WriteString(writer, summary.String())
+
+	return err
+}
@@ -0,0 +1,118 @@
+package report
+
+import (
+	"content_validator/internal/validation"
+	"encoding/json"
+	"io"
+)
+
+// jsonReport is the document written by the json format. Its field names are part of the output format, so they
+// must not be renamed.
+type jsonReport struct {
+	File     string        `json:"file"`
+	Failed   bool          `json:"failed"`
+	FailOn   string        `json:"failOn"`
+	Findings []jsonFinding `json:"findings"`
+	Summary  jsonSummary   `json:"summary"`
+}
+
+type jsonFinding struct {
+	RuleID     string        `json:"ruleId"`
+	Severity   string        `json:"severity"`
+	GenreIndex int           `json:"genreIndex"`
+	Genre      string        `json:"genre"`
+	Field      string        `json:"field"`
+	Value      string        `json:"value"`
+	Position   *jsonPosition `json:"position"`
+	Message    string        `json:"message"`
+}
+
+type jsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// jsonSummary counts the findings. Rules lists every rule of the run, including the rules without findings, so
+// consumers can tell a clean rule from a rule that did not run.
+type jsonSummary struct {
+	Total      int                      `json:"total"`
+	Severities map[string]int           `json:"severities"`
+	Rules      map[string]jsonRuleCount `json:"rules"`
+}
+
+type jsonRuleCount struct {
+	Severity string `json:"severity"`
+	Count    int    `json:"count"`
+}
+
+// jsonReporter writes the report as a single indented JSON document.
+type jsonReporter struct{}
+
+func (jsonReporter) Write(writer io.Writer, report Report) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(newJSONReport(report))
+}
+
+func newJSONReport(report Report) jsonReport {
+	summary := jsonSummary{
+		Total: len(report.Findings),
+		Severities: map[string]int{
+			string(validation.SeverityError):   0,
+			string(validation.SeverityWarning): 0,
+			string(validation.SeverityInfo):    0,
+		},
+		Rules: make(map[string]jsonRuleCount, len(report.Rules)),
+	}
+
+	for _, rule := range report.Rules {
+		summary.Rules[rule.ID] = jsonRuleCount{Severity: string(rule.DefaultSeverity), Count: 0}
+	}
+
+	findings := make([]jsonFinding, 0, len(report.Findings))
+
+	for _, finding := range report.Findings {
+		summary.Severities[string(finding.Severity)]++
+
+		ruleCount, ok := summary.Rules[finding.RuleID]
+
+		if !ok {
+			// NOTE: Findings of internal rules, like unused suppressions, have no configured rule.
+			ruleCount.Severity = string(finding.Severity)
+		}
+
+		ruleCount.Count++
+		summary.Rules[finding.RuleID] = ruleCount
+
+		findings = append(findings, newJSONFinding(finding))
+	}
+
+	return jsonReport{
+		File:     report.Document.Path,
+		Failed:   report.Failed(),
+		FailOn:   string(report.FailOn),
+		Findings: findings,
+		Summary:  summary,
+	}
+}
+
+func newJSONFinding(finding validation.Finding) jsonFinding {
+	var position *jsonPosition
+
+	if finding.Position.IsKnown() {
+		position = &jsonPosition{Line: finding.Position.Line, Column: finding.Position.Column}
+	}
+
+	return jsonFinding{
+		RuleID:     finding.RuleID,
+		Severity:   string(finding.Severity),
+		GenreIndex: finding.GenreIndex,
+		Genre:      finding.GenreName,
+		Field:      finding.Field,
+		Value:      finding.Value,
+		Position:   position,
+		Message:    finding.Message,
+	}
+}
@@ -0,0 +1,218 @@
+package report
+
+import (
+	"content_validator/internal/validation"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// junitTestSuites is the root element of a JUnit XML report, in the dialect understood by most CI test-result views.
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitReporter writes the report as JUnit XML: one test suite per rule and, in every suite, one test case per
+// genre. A test case fails if the rule reported failing findings for the genre, findings below the fail-on threshold
+// are listed in the test case's output instead.
+//
+// Note:
+//
+//	Findings that are not about a genre, like unused suppressions, get a test suite of their own with a test case
+//	per finding.
+type junitReporter struct{}
+
+func (junitReporter) Write(writer io.Writer, report Report) error {
+	_, err := io.WriteString(writer, xml.Header)
+
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "\t")
+
+	err = encoder.Encode(newJUnitTestSuites(report))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(writer, "\n")
+
+	return err
+}
+
+func newJUnitTestSuites(report Report) junitTestSuites {
+	findingsByRule := make(map[string][]validation.Finding)
+
+	var otherRuleIDs []string
+
+	for _, finding := range report.Findings {
+		if _, ok := findingsByRule[finding.RuleID]; !ok && !hasRule(report.Rules, finding.RuleID) {
+			otherRuleIDs = append(otherRuleIDs, finding.RuleID)
+		}
+
+		findingsByRule[finding.RuleID] = append(findingsByRule[finding.RuleID], finding)
+	}
+
+	testSuites := junitTestSuites{
+		XMLName:    xml.Name{Space: "", Local: "testsuites"},
+		Name:       toolName,
+		Tests:      0,
+		Failures:   0,
+		TestSuites: make([]junitTestSuite, 0, len(report.Rules)+len(otherRuleIDs)),
+	}
+
+	for _, rule := range report.Rules {
+		testSuites.TestSuites = append(testSuites.TestSuites, newRuleTestSuite(report, rule.ID,
+			findingsByRule[rule.ID]))
+	}
+
+	for _, ruleID := range otherRuleIDs {
+		testSuites.TestSuites = append(testSuites.TestSuites, newFindingsTestSuite(report, ruleID,
+			findingsByRule[ruleID]))
+	}
+
+	for _, testSuite := range testSuites.TestSuites {
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+	}
+
+	return testSuites
+}
+
+func hasRule(rules []validation.Rule, ruleID string) bool {
+	for _, rule := range rules {
+		if rule.ID == ruleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newRuleTestSuite creates the test suite of a rule, with a test case for every genre of the report.
+func newRuleTestSuite(report Report, ruleID string, findings []validation.Finding) junitTestSuite {
+	findingsByGenre := make(map[int][]validation.Finding, len(findings))
+
+	for _, finding := range findings {
+		findingsByGenre[finding.GenreIndex] = append(findingsByGenre[finding.GenreIndex], finding)
+	}
+
+	testSuite := junitTestSuite{
+		Name:      ruleID,
+		Tests:     len(report.Document.Genres),
+		Failures:  0,
+		TestCases: make([]junitTestCase, 0, len(report.Document.Genres)),
+	}
+
+	for genreIndex, genre := range report.Document.Genres {
+		testCase := junitTestCase{
+			ClassName: ruleID,
+			Name:      fmt.Sprintf("genre #%d %q", genreIndex, genre.Name),
+			File:      report.Document.Path,
+			Line:      0,
+			Failure:   nil,
+			SystemOut: "",
+		}
+
+		if genreIndex < len(report.Document.Positions) {
+			testCase.Line = report.Document.Positions[genreIndex].Genre.Line
+		}
+
+		addJUnitFindings(&testCase, findingsByGenre[genreIndex], report.FailOn)
+
+		if testCase.Failure != nil {
+			testSuite.Failures++
+		}
+
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	return testSuite
+}
+
+// newFindingsTestSuite creates the test suite of an internal rule, with a test case for every finding.
+func newFindingsTestSuite(report Report, ruleID string, findings []validation.Finding) junitTestSuite {
+	testSuite := junitTestSuite{
+		Name:      ruleID,
+		Tests:     len(findings),
+		Failures:  0,
+		TestCases: make([]junitTestCase, 0, len(findings)),
+	}
+
+	for _, finding := range findings {
+		testCase := junitTestCase{
+			ClassName: ruleID,
+			Name:      finding.Subject(),
+			File:      report.Document.Path,
+			Line:      finding.Position.Line,
+			Failure:   nil,
+			SystemOut: "",
+		}
+
+		addJUnitFindings(&testCase, []validation.Finding{finding}, report.FailOn)
+
+		if testCase.Failure != nil {
+			testSuite.Failures++
+		}
+
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	return testSuite
+}
+
+// addJUnitFindings records the findings in the test case: failing findings make up its failure, the other findings
+// its output.
+func addJUnitFindings(testCase *junitTestCase, findings []validation.Finding, failOn validation.Severity) {
+	var failingLines, otherLines []string
+
+	for _, finding := range findings {
+		if !finding.Severity.AtLeast(failOn) {
+			otherLines = append(otherLines, finding.String())
+
+			continue
+		}
+
+		if testCase.Failure == nil {
+			testCase.Failure = &junitFailure{Message: finding.Message, Type: string(finding.Severity), Text: ""}
+		}
+
+		failingLines = append(failingLines, finding.String())
+	}
+
+	if testCase.Failure != nil {
+		testCase.Failure.Text = strings.Join(failingLines, "\n")
+	}
+
+	testCase.SystemOut = strings.Join(otherLines, "\n")
+}
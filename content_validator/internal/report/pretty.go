@@ -0,0 +1,197 @@
+package report
+
+import (
+	"bytes"
+	"content_validator/internal/validation"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// NoColorEnvironmentVariable disables colors when set to a non-empty value, see https://no-color.org.
+const NoColorEnvironmentVariable = "NO_COLOR"
+
+// tabWidth is the number of columns a tab occupies in the snippets.
+const tabWidth = 4
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiBlue   = "\x1b[34m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// severityColors maps severities to the ANSI colors of their labels and underlines.
+var severityColors = map[validation.Severity]string{
+	validation.SeverityError:   ansiRed,
+	validation.SeverityWarning: ansiYellow,
+	validation.SeverityInfo:    ansiCyan,
+}
+
+// IsTerminal reports whether the writer is a terminal, e.g. os.Stdout of an interactive run.
+func IsTerminal(writer io.Writer) bool {
+	file, ok := writer.(*os.File)
+
+	if !ok {
+		return false
+	}
+
+	fileInfo, err := file.Stat()
+
+	return err == nil && fileInfo.Mode()&os.ModeCharDevice != 0
+}
+
+// palette colors text with ANSI escape sequences, or leaves it as is if colors are disabled.
+type palette struct {
+	enabled bool
+}
+
+func (palette palette) paint(color string, text string) string {
+	if !palette.enabled {
+		return text
+	}
+
+	return color + text + ansiReset
+}
+
+// prettyReporter renders the findings compiler-style, for people: every finding is shown with the line of the
+// validated file it points to, the offending value underlined, and the hint of its rule. The output is colored when
+// it goes to a terminal, unless colors are disabled with NoColorEnvironmentVariable.
+//
+// Fields:
+//
+//	getenv: Looks up environment variables, os.Getenv outside of tests
+//
+// Examples:
+//
+//	error[name-case]: genre #0 "4X": genre name is not in lowercase
+//	 --> genres.json:3:11
+//	  |
+//	3 |         "name": "4X",
+//	  |                 ^^^^
+//	  = hint: lowercase the name, or list it in the "exceptions" option if it is an acronym
+type prettyReporter struct {
+	getenv func(key string) string
+}
+
+func (reporter prettyReporter) Write(writer io.Writer, report Report) error {
+	colors := palette{enabled: reporter.getenv(NoColorEnvironmentVariable) == "" && IsTerminal(writer)}
+	sourceLines := bytes.Split(report.Document.Content, []byte("\n"))
+	hints := make(map[string]string, len(report.Rules))
+
+	for _, rule := range report.Rules {
+		hints[rule.ID] = rule.Hint
+	}
+
+	var output strings.Builder
+
+	for _, finding := range report.Findings {
+		writePrettyFinding(&output, finding, report.Document.Path, sourceLines, hints[finding.RuleID], colors)
+	}
+
+	if len(report.Findings) > 0 {
+		counts := validation.CountFindings(report.Findings)
+
+		fmt.Fprintf(&output, "%s %d error(s), %d warning(s), %d info(s)\n", colors.paint(ansiBold, "Found"),
+			counts[validation.SeverityError], counts[validation.SeverityWarning], counts[validation.SeverityInfo])
+	}
+
+	_, err := io.WriteString(writer, output.String())
+
+	return err
+}
+
+func writePrettyFinding(output *strings.Builder, finding validation.Finding, file string, sourceLines [][]byte,
+	hint string, colors palette) {
+	severityColor := severityColors[finding.Severity]
+
+	fmt.Fprintf(output, "%s%s\n",
+		colors.paint(ansiBold+severityColor, fmt.Sprintf("%s[%s]", finding.Severity, finding.RuleID)),
+		colors.paint(ansiBold, fmt.Sprintf(": %s: %s", finding.Subject(), finding.Message)))
+
+	position := finding.Position
+	hasSnippet := position.IsKnown() && position.Line <= len(sourceLines)
+	gutterWidth := 1
+
+	if hasSnippet {
+		gutterWidth = len(strconv.Itoa(position.Line))
+	}
+
+	gutter := strings.Repeat(" ", gutterWidth)
+
+	if position.IsKnown() {
+		fmt.Fprintf(output, "%s%s %s:%d:%d\n", gutter, colors.paint(ansiBlue, "-->"), file, position.Line,
+			position.Column)
+	}
+
+	if hasSnippet {
+		sourceLine := string(bytes.TrimSuffix(sourceLines[position.Line-1], []byte("\r")))
+		indent, underlineWidth := underlineSpan(sourceLine, position.Column)
+
+		fmt.Fprintf(output, "%s %s\n", gutter, colors.paint(ansiBlue, "|"))
+		fmt.Fprintf(output, "%s %s %s\n", colors.paint(ansiBlue, strconv.Itoa(position.Line)),
+			colors.paint(ansiBlue, "|"), expandTabs(sourceLine))
+		fmt.Fprintf(output, "%s %s %s%s\n", gutter, colors.paint(ansiBlue, "|"), strings.Repeat(" ", indent),
+			colors.paint(ansiBold+severityColor, strings.Repeat("^", underlineWidth)))
+	}
+
+	if hint != "" {
+		fmt.Fprintf(output, "%s %s %s: %s\n", gutter, colors.paint(ansiBlue, "="), colors.paint(ansiBold, "hint"),
+			hint)
+	}
+
+	output.WriteString("\n")
+}
+
+// underlineSpan returns the display column (after expanding tabs) at which the value starting at the given
+// 1-based character column of the line begins, and how many columns the value occupies. A string value is
+// underlined from its opening to its closing quote, any other value is underlined by a single character.
+func underlineSpan(line string, column int) (int, int) {
+	valueStart := len(line)
+	characterIndex := 0
+
+	for byteIndex := range line {
+		if characterIndex == column-1 {
+			valueStart = byteIndex
+
+			break
+		}
+
+		characterIndex++
+	}
+
+	indent := displayWidth(line[:valueStart])
+	value := line[valueStart:]
+
+	if !strings.HasPrefix(value, `"`) {
+		return indent, 1
+	}
+
+	escaped := false
+
+	for byteIndex, character := range value[1:] {
+		switch {
+		case escaped:
+			escaped = false
+		case character == '\\':
+			escaped = true
+		case character == '"':
+			return indent, displayWidth(value[:byteIndex+2])
+		}
+	}
+
+	return indent, max(displayWidth(value), 1)
+}
+
+func displayWidth(text string) int {
+	return utf8.RuneCountInString(text) + strings.Count(text, "\t")*(tabWidth-1)
+}
+
+func expandTabs(text string) string {
+	return strings.ReplaceAll(text, "\t", strings.Repeat(" ", tabWidth))
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/validation"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+var errUnknownFormat = errors.New("unknown format")
+
+// Report is everything a reporter needs to describe the result of a validation run.
+//
+// Fields:
+//
+//	Document: The validated file
+//	Rules: The rules that were configured for the run, in the order they ran
+//	Findings: The findings of the run, in file order
+//	FailOn: The lowest severity that fails the validation
+type Report struct {
+	Document data.Document
+	Rules    []validation.Rule
+	Findings []validation.Finding
+	FailOn   validation.Severity
+}
+
+// Failed reports whether the findings fail the validation.
+func (report Report) Failed() bool {
+	return validation.HasFailures(report.Findings, report.FailOn)
+}
+
+// Reporter writes a report in a specific output format.
+type Reporter interface {
+	// Write writes the report to the writer.
+	//
+	// Parameters:
+	//
+	//	writer: The destination of the formatted report, usually os.Stdout
+	//	report: The report to write
+	//
+	// Returns:
+	//
+	//	error: An error if the report cannot be written
+	Write(writer io.Writer, report Report) error
+}
+
+// reporters holds the reporter of every supported format, keyed by the format name.
+var reporters = map[string]Reporter{
+	"text":   textReporter{},
+	"github": githubReporter{getenv: os.Getenv},
+	"json":   jsonReporter{},
+	"junit":  junitReporter{},
+	"pretty": prettyReporter{getenv: os.Getenv},
+	"sarif":  sarifReporter{},
+}
+
+// Formats returns the names of the supported formats, sorted alphabetically.
+func Formats() []string {
+	formats := make([]string, 0, len(reporters))
+
+	for format := range reporters {
+		formats = append(formats, format)
+	}
+
+	slices.Sort(formats)
+
+	return formats
+}
+
+// ForFormat returns the reporter of the given format.
+//
+// Parameters:
+//
+//	format: One of the names returned by Formats
+//
+// Returns:
+//
+//	Reporter: The reporter of the format
+//	error: An error if the format is not supported
+//
+// Examples:
+//
+//	reporter, err := ForFormat("json")
+//
+//	if err != nil {
+//	    log.Fatalf("Invalid -format value: %v", err)
+//	}
+//
+//	err = reporter.Write(os.Stdout, report)
+func ForFormat(format string) (Reporter, error) {
+	reporter, ok := reporters[format]
+
+	if !ok {
+		return nil, fmt.Errorf("%w %q (expected one of: %s)", errUnknownFormat, format,
+			strings.Join(Formats(), ", "))
+	}
+
+	return reporter, nil
+}
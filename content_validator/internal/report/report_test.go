@@ -0,0 +1,428 @@
+package report
+
+import (
+	"bytes"
+	"content_validator/internal/data"
+	"content_validator/internal/validation"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testReport returns a report with one error and one warning, for rules that are registered in the validation
+// package.
+func testReport() Report {
+	rules := validation.Rules()
+
+	return Report{
+		Document: data.Document{
+			Path:    "genres.json",
+			Content: nil,
+			Genres:  []data.GameGenre{{Name: "", AltNames: []string{}}, {Name: "action", AltNames: []string{}}},
+			Positions: []data.GenrePositions{
+				{Genre: data.Position{Line: 2, Column: 2}, Name: data.Position{Line: 3, Column: 11}, AltNames: nil},
+				{Genre: data.Position{Line: 6, Column: 2}, Name: data.Position{Line: 7, Column: 11}, AltNames: nil},
+			},
+		},
+		Rules: rules[:2],
+		Findings: []validation.Finding{
+			{
+				RuleID:     rules[0].ID,
+				Severity:   validation.SeverityError,
+				GenreIndex: 0,
+				GenreName:  "",
+				Field:      validation.NameField,
+				Value:      "",
+				Message:    "genre name is empty",
+				File:       "genres.json",
+				Position:   data.Position{Line: 3, Column: 11},
+			},
+			{
+				RuleID:     validation.UnusedSuppressionRuleID,
+				Severity:   validation.SeverityWarning,
+				GenreIndex: -1,
+				GenreName:  "action",
+				Field:      "",
+				Value:      "",
+				Message:    "suppression is stale",
+				File:       "genres.json",
+				Position:   data.Position{Line: 0, Column: 0},
+			},
+		},
+		FailOn: validation.SeverityError,
+	}
+}
+
+func TestForFormat(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	for _, format := range Formats() {
+		reporter, err := ForFormat(format)
+
+		if err != nil || reporter == nil {
+			testRunner.Errorf("format %q: got reporter %v and error %v", format, reporter, err)
+		}
+	}
+
+	_, err := ForFormat("yaml")
+
+	if err == nil {
+		testRunner.Error("got no error for an unknown format")
+	}
+}
+
+func TestTextReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	var output bytes.Buffer
+
+	err := textReporter{}.Write(&output, testReport())
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+	wantLastLine := "Found 1 error(s), 1 warning(s), 0 info(s)"
+
+	if len(lines) != 3 || lines[2] != wantLastLine {
+		testRunner.Errorf("got lines %q, want 2 findings and %q", lines, wantLastLine)
+	}
+
+	if !strings.HasPrefix(lines[0], "genres.json:3:11: error: ") {
+		testRunner.Errorf("got first line %q, want it to start with the position", lines[0])
+	}
+}
+
+func TestJSONReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	report := testReport()
+
+	var output bytes.Buffer
+
+	err := jsonReporter{}.Write(&output, report)
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded jsonReport
+
+	err = json.Unmarshal(output.Bytes(), &decoded)
+
+	if err != nil {
+		testRunner.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	if !decoded.Failed || decoded.File != "genres.json" || len(decoded.Findings) != 2 {
+		testRunner.Errorf("got report %+v, want a failed report of genres.json with 2 findings", decoded)
+	}
+
+	if got := decoded.Findings[0].Position; got == nil || *got != (jsonPosition{Line: 3, Column: 11}) {
+		testRunner.Errorf("got position %v of the first finding, want 3:11", got)
+	}
+
+	if got := decoded.Findings[1].Position; got != nil {
+		testRunner.Errorf("got position %v of the second finding, want none", got)
+	}
+
+	wantSummary := jsonSummary{
+		Total:      2,
+		Severities: map[string]int{"error": 1, "warning": 1, "info": 0},
+		Rules: map[string]jsonRuleCount{
+			report.Rules[0].ID:                 {Severity: string(report.Rules[0].DefaultSeverity), Count: 1},
+			report.Rules[1].ID:                 {Severity: string(report.Rules[1].DefaultSeverity), Count: 0},
+			validation.UnusedSuppressionRuleID: {Severity: "warning", Count: 1},
+		},
+	}
+
+	if !reflect.DeepEqual(decoded.Summary, wantSummary) {
+		testRunner.Errorf("got summary %+v, want %+v", decoded.Summary, wantSummary)
+	}
+}
+
+func TestSARIFReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	report := testReport()
+
+	var output bytes.Buffer
+
+	err := sarifReporter{}.Write(&output, report)
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded sarifLog
+
+	err = json.Unmarshal(output.Bytes(), &decoded)
+
+	if err != nil {
+		testRunner.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	if decoded.Version != sarifVersion || len(decoded.Runs) != 1 {
+		testRunner.Fatalf("got version %q with %d runs, want version %q with 1 run", decoded.Version,
+			len(decoded.Runs), sarifVersion)
+	}
+
+	run := decoded.Runs[0]
+	wantRuleIDs := []string{report.Rules[0].ID, report.Rules[1].ID, validation.UnusedSuppressionRuleID}
+
+	var gotRuleIDs []string
+
+	for _, descriptor := range run.Tool.Driver.Rules {
+		gotRuleIDs = append(gotRuleIDs, descriptor.ID)
+	}
+
+	if !reflect.DeepEqual(gotRuleIDs, wantRuleIDs) {
+		testRunner.Errorf("got descriptors %v, want %v", gotRuleIDs, wantRuleIDs)
+	}
+
+	wantResults := []sarifResult{
+		{
+			RuleID:    report.Rules[0].ID,
+			RuleIndex: 0,
+			Level:     "error",
+			Message:   sarifMessage{Text: `genre #0 "": genre name is empty`},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "genres.json"},
+				Region:           &sarifRegion{StartLine: 3, StartColumn: 11},
+			}}},
+		},
+		{
+			RuleID:    validation.UnusedSuppressionRuleID,
+			RuleIndex: 2,
+			Level:     "warning",
+			Message:   sarifMessage{Text: `genre "action": suppression is stale`},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "genres.json"},
+				Region:           nil,
+			}}},
+		},
+	}
+
+	if !reflect.DeepEqual(run.Results, wantResults) {
+		testRunner.Errorf("got results %+v, want %+v", run.Results, wantResults)
+	}
+}
+
+func TestSARIFURI(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "genres.json", want: "genres.json"},
+		{file: "data/my genres.json", want: "data/my%20genres.json"},
+		{file: "data:genres.json", want: "./data:genres.json"},
+		{file: "/home/user/my genres.json", want: "file:///home/user/my%20genres.json"},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.file, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := sarifURI(test.file); got != test.want {
+				runner.Errorf("sarifURI(%q) = %q, want %q", test.file, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGitHubReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	stepSummaryPath := filepath.Join(testRunner.TempDir(), "step_summary.md")
+	reporter := githubReporter{getenv: func(key string) string {
+		if key == StepSummaryEnvironmentVariable {
+			return stepSummaryPath
+		}
+
+		return ""
+	}}
+
+	var output bytes.Buffer
+
+	err := reporter.Write(&output, testReport())
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	wantOutput := "::error file=genres.json,line=3,col=11,title=name-not-empty::genre #0 \"\": genre name is empty\n" +
+		"::warning file=genres.json,title=unused-suppression::genre \"action\": suppression is stale\n"
+
+	if output.String() != wantOutput {
+		testRunner.Errorf("got output %q, want %q", output.String(), wantOutput)
+	}
+
+	stepSummary, err := os.ReadFile(stepSummaryPath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to read step summary: %v", err)
+	}
+
+	wantStepSummary := "## Validation of `genres.json`\n\n" +
+		":x: Failed: 1 error(s), 1 warning(s), 0 info(s).\n\n" +
+		"| Location | Severity | Rule | Finding |\n| --- | --- | --- | --- |\n" +
+		"| 3:11 | error | `name-not-empty` | genre #0 \"\": genre name is empty |\n" +
+		"| - | warning | `unused-suppression` | genre \"action\": suppression is stale |\n\n"
+
+	if string(stepSummary) != wantStepSummary {
+		testRunner.Errorf("got step summary %q, want %q", stepSummary, wantStepSummary)
+	}
+}
+
+func TestGitHubAnnotationEscaping(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	finding := testReport().Findings[0]
+	finding.Message = "100% broken,\nreally"
+
+	want := "::error file=dir%2Cname/genres%3A1.json,line=3,col=11,title=name-not-empty::" +
+		"genre #0 \"\": 100%25 broken,%0Areally"
+
+	if got := githubAnnotation("dir,name/genres:1.json", finding); got != want {
+		testRunner.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestJUnitReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	report := testReport()
+
+	var output bytes.Buffer
+
+	err := junitReporter{}.Write(&output, report)
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded junitTestSuites
+
+	err = xml.Unmarshal(output.Bytes(), &decoded)
+
+	if err != nil {
+		testRunner.Fatalf("output is not valid XML: %v", err)
+	}
+
+	wantSuites := []struct {
+		name      string
+		tests     int
+		failures  int
+		testCases []string
+	}{
+		{name: report.Rules[0].ID, tests: 2, failures: 1, testCases: []string{`genre #0 ""`, `genre #1 "action"`}},
+		{name: report.Rules[1].ID, tests: 2, failures: 0, testCases: []string{`genre #0 ""`, `genre #1 "action"`}},
+		{name: validation.UnusedSuppressionRuleID, tests: 1, failures: 0, testCases: []string{`genre "action"`}},
+	}
+
+	if decoded.Tests != 5 || decoded.Failures != 1 || len(decoded.TestSuites) != len(wantSuites) {
+		testRunner.Fatalf("got %d tests, %d failures and %d suites, want 5 tests, 1 failure and %d suites",
+			decoded.Tests, decoded.Failures, len(decoded.TestSuites), len(wantSuites))
+	}
+
+	for suiteIndex, wantSuite := range wantSuites {
+		testSuite := decoded.TestSuites[suiteIndex]
+
+		var gotTestCases []string
+
+		for _, testCase := range testSuite.TestCases {
+			gotTestCases = append(gotTestCases, testCase.Name)
+		}
+
+		if testSuite.Name != wantSuite.name || testSuite.Tests != wantSuite.tests ||
+			testSuite.Failures != wantSuite.failures || !reflect.DeepEqual(gotTestCases, wantSuite.testCases) {
+			testRunner.Errorf("got suite %q with %d tests, %d failures and cases %q, want %+v", testSuite.Name,
+				testSuite.Tests, testSuite.Failures, gotTestCases, wantSuite)
+		}
+	}
+
+	failedTestCase := decoded.TestSuites[0].TestCases[0]
+	wantFailure := junitFailure{
+		Message: "genre name is empty",
+		Type:    "error",
+		Text:    `genres.json:3:11: error: genre #0 "": genre name is empty [name-not-empty]`,
+	}
+
+	if failedTestCase.Failure == nil || *failedTestCase.Failure != wantFailure || failedTestCase.Line != 2 {
+		testRunner.Errorf("got failed test case %+v, want failure %+v on line 2", failedTestCase, wantFailure)
+	}
+
+	warningTestCase := decoded.TestSuites[2].TestCases[0]
+
+	if warningTestCase.Failure != nil || warningTestCase.SystemOut == "" {
+		testRunner.Errorf("got warning test case %+v, want the warning in its output", warningTestCase)
+	}
+}
+
+func TestPrettyReporter(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	report := testReport()
+	report.Document.Content = []byte("[\n\t{\n\t\t\"name\": \"\",\n\t\t\"altNames\": []\n\t}\n]")
+
+	var output bytes.Buffer
+
+	err := prettyReporter{getenv: func(string) string { return "" }}.Write(&output, report)
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "error[name-not-empty]: genre #0 \"\": genre name is empty\n" +
+		" --> genres.json:3:11\n" +
+		"  |\n" +
+		"3 |         \"name\": \"\",\n" +
+		"  |                 ^^\n" +
+		"  = hint: " + report.Rules[0].Hint + "\n" +
+		"\n" +
+		"warning[unused-suppression]: genre \"action\": suppression is stale\n" +
+		"\n" +
+		"Found 1 error(s), 1 warning(s), 0 info(s)\n"
+
+	if output.String() != want {
+		testRunner.Errorf("got output\n%s\nwant\n%s", output.String(), want)
+	}
+}
+
+func TestUnderlineSpan(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name        string
+		line        string
+		column      int
+		wantIndent  int
+		wantColumns int
+	}{
+		{name: "string after tabs", line: "\t\t\"name\": \"4X\",", column: 11, wantIndent: 16, wantColumns: 4},
+		{name: "escaped quote", line: `["a\"b", 1]`, column: 2, wantIndent: 1, wantColumns: 6},
+		{name: "non-ASCII string", line: `["jeu d'été"]`, column: 2, wantIndent: 1, wantColumns: 11},
+		{name: "object", line: "\t{", column: 2, wantIndent: 4, wantColumns: 1},
+		{name: "unterminated string", line: `["abc`, column: 2, wantIndent: 1, wantColumns: 4},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			indent, columns := underlineSpan(test.line, test.column)
+
+			if indent != test.wantIndent || columns != test.wantColumns {
+				runner.Errorf("got indent %d and width %d, want %d and %d", indent, columns, test.wantIndent,
+					test.wantColumns)
+			}
+		})
+	}
+}
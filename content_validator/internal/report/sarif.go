@@ -0,0 +1,196 @@
+package report
+
+import (
+	"content_validator/internal/validation"
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "content_validator"
+)
+
+// sarifLevels maps severities to SARIF result levels.
+var sarifLevels = map[validation.Severity]string{
+	validation.SeverityError:   "error",
+	validation.SeverityWarning: "warning",
+	validation.SeverityInfo:    "note",
+}
+
+// sarifLog is the subset of the SARIF 2.1.0 log format that the sarif format writes.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string                     `json:"name"`
+	Rules []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID                   string               `json:"id"`
+	ShortDescription     *sarifMessage        `json:"shortDescription,omitempty"`
+	DefaultConfiguration sarifConfiguration   `json:"defaultConfiguration"`
+	Properties           *sarifRuleProperties `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProperties struct {
+	Category string `json:"category"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+// sarifReporter writes the report as a SARIF 2.1.0 log with a single run, for code scanning dashboards.
+type sarifReporter struct{}
+
+func (sarifReporter) Write(writer io.Writer, report Report) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(newSARIFLog(report))
+}
+
+func newSARIFLog(report Report) sarifLog {
+	descriptors := make([]sarifReportingDescriptor, 0, len(report.Rules))
+	ruleIndexes := make(map[string]int, len(report.Rules))
+
+	for _, rule := range report.Rules {
+		ruleIndexes[rule.ID] = len(descriptors)
+		descriptors = append(descriptors, sarifReportingDescriptor{
+			ID:                   rule.ID,
+			ShortDescription:     &sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevels[rule.DefaultSeverity]},
+			Properties:           &sarifRuleProperties{Category: rule.Category.String()},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(report.Findings))
+
+	for _, finding := range report.Findings {
+		ruleIndex, ok := ruleIndexes[finding.RuleID]
+
+		if !ok {
+			// NOTE: Results must refer to a descriptor, so internal rules, like unused suppressions, get a minimal one.
+			ruleIndex = len(descriptors)
+			ruleIndexes[finding.RuleID] = ruleIndex
+			descriptors = append(descriptors, sarifReportingDescriptor{
+				ID:                   finding.RuleID,
+				ShortDescription:     nil,
+				DefaultConfiguration: sarifConfiguration{Level: sarifLevels[finding.Severity]},
+				Properties:           nil,
+			})
+		}
+
+		results = append(results, sarifResult{
+			RuleID:    finding.RuleID,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevels[finding.Severity],
+			Message:   sarifMessage{Text: sarifMessageText(finding)},
+			Locations: []sarifLocation{{PhysicalLocation: newSARIFPhysicalLocation(report.Document.Path, finding)}},
+		})
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool:       sarifTool{Driver: sarifDriver{Name: toolName, Rules: descriptors}},
+				ColumnKind: "unicodeCodePoints",
+				Results:    results,
+			},
+		},
+	}
+}
+
+// sarifMessageText returns the message of the finding prefixed with the offending genre and value, since SARIF
+// viewers show the message without the rest of the finding.
+func sarifMessageText(finding validation.Finding) string {
+	return finding.Subject() + ": " + finding.Message
+}
+
+func newSARIFPhysicalLocation(file string, finding validation.Finding) sarifPhysicalLocation {
+	location := sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation{URI: sarifURI(file)},
+		Region:           nil,
+	}
+
+	if finding.Position.IsKnown() {
+		location.Region = &sarifRegion{StartLine: finding.Position.Line, StartColumn: finding.Position.Column}
+	}
+
+	return location
+}
+
+// sarifURI returns the URI of a file for SARIF artifact locations: a file URI for an absolute path, or a relative
+// reference with forward slashes otherwise, both with the characters that are not allowed in URIs escaped.
+//
+// Examples:
+//
+//	sarifURI("genres.json")             // returns "genres.json"
+//	sarifURI("data/my genres.json")     // returns "data/my%20genres.json"
+//	sarifURI("/home/user/genres.json")  // returns "file:///home/user/genres.json"
+func sarifURI(file string) string {
+	path := filepath.ToSlash(file)
+
+	if !filepath.IsAbs(file) {
+		return (&url.URL{Path: path}).String()
+	}
+
+	// NOTE: absolute Windows paths start with the drive letter, the path of a file URI starts with a slash.
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
@@ -0,0 +1,31 @@
+package report
+
+import (
+	"content_validator/internal/validation"
+	"fmt"
+	"io"
+)
+
+// textReporter writes one line per finding, followed by the number of findings of each severity.
+type textReporter struct{}
+
+func (textReporter) Write(writer io.Writer, report Report) error {
+	for _, finding := range report.Findings {
+		_, err := fmt.Fprintln(writer, finding)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(report.Findings) == 0 {
+		return nil
+	}
+
+	counts := validation.CountFindings(report.Findings)
+
+	_, err := fmt.Fprintf(writer, "Found %d error(s), %d warning(s), %d info(s)\n",
+		counts[validation.SeverityError], counts[validation.SeverityWarning], counts[validation.SeverityInfo])
+
+	return err
+}
@@ -0,0 +1,128 @@
+package textkey
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Combining kana voicing marks, which are combining marks but not diacritics.
+const (
+	kanaVoicedSoundMark     = '\u3099'
+	kanaSemiVoicedSoundMark = '\u309A'
+)
+
+// separators are the characters ignored by WithoutSeparators besides whitespace: hyphens, dashes and apostrophes,
+// including the look-alikes that are used instead of them.
+var separators = []rune{
+	'-', '\u00AD', '\u2010', '\u2011', '\u2012', '\u2013', '\u2014', '\u2015', '\u2212',
+	'\'', '`', '\u00B4', '\u02BC', '\u2018', '\u2019', '\u2032',
+}
+
+// longVowels maps the vowels with a macron, which mark long vowels in the Hepburn romanization of Japanese, to the
+// way they are spelled out without it ("bishōjo" is also written "bishoujo").
+var longVowels = strings.NewReplacer(
+	"ā", "aa", "ē", "ee", "ī", "ii", "ō", "ou", "ū", "uu",
+	"Ā", "Aa", "Ē", "Ee", "Ī", "Ii", "Ō", "Ou", "Ū", "Uu",
+)
+
+// Folded returns the key two strings share if they only differ by case, diacritics or character width: the string
+// with its full-width and half-width characters replaced by their usual form, its diacritics removed and its case
+// folded.
+//
+// Parameters:
+//
+//	value: The string to fold
+//
+// Returns:
+//
+//	string: The folded string, in NFC
+//
+// Examples:
+//
+//	Folded("Bishōjo")  // returns "bishojo"
+//	Folded("ＲＰＧ")      // returns "rpg"
+//	Folded("Pokémon")  // returns "pokemon"
+func Folded(value string) string {
+	// NOTE: transformers keep state between calls, so a new chain is built for every string.
+	folded, _, err := transform.String(transform.Chain(
+		width.Fold,
+		norm.NFD,
+		runes.Remove(runes.Predicate(isDiacritic)),
+		norm.NFC,
+		cases.Fold(),
+	), value)
+
+	if err != nil {
+		return value
+	}
+
+	return folded
+}
+
+// isDiacritic reports whether a character is a combining mark that is removed by Folded. The kana voicing marks
+// are kept, since they change the sound rather than the accent ("カ" is "ka", "ガ" is "ga").
+func isDiacritic(character rune) bool {
+	return unicode.Is(unicode.Mn, character) && character != kanaVoicedSoundMark && character != kanaSemiVoicedSoundMark
+}
+
+// FoldedVariants returns the keys of the ways a string is spelled that only differ by case, diacritics or character
+// width (see Folded). A string with long vowels written with a macron has a second key, with the long vowels spelled
+// out.
+//
+// Parameters:
+//
+//	value: The string to fold
+//
+// Returns:
+//
+//	[]string: The distinct folded variants of the string, the folded string first
+//
+// Examples:
+//
+//	FoldedVariants("bishojo")   // returns []string{"bishojo"}
+//	FoldedVariants("bishōjo")   // returns []string{"bishojo", "bishoujo"}
+//	FoldedVariants("bishoujo")  // returns []string{"bishoujo"}
+func FoldedVariants(value string) []string {
+	folded := Folded(value)
+	spelledOut := Folded(longVowels.Replace(norm.NFC.String(value)))
+
+	if spelledOut == folded {
+		return []string{folded}
+	}
+
+	return []string{folded, spelledOut}
+}
+
+// WithoutSeparators returns the key two strings share if they only differ by the hyphens, spaces and apostrophes
+// between their words: the string with all whitespace, hyphens, dashes and apostrophes removed.
+//
+// Parameters:
+//
+//	value: The string to strip
+//
+// Returns:
+//
+//	string: The string without separators
+//
+// Examples:
+//
+//	WithoutSeparators("run-n-gun")       // returns "runngun"
+//	WithoutSeparators("run 'n' gun")     // returns "runngun"
+//	WithoutSeparators("side  scroller")  // returns "sidescroller"
+//	WithoutSeparators("beat ’em up")     // returns "beatemup"
+func WithoutSeparators(value string) string {
+	return strings.Map(func(character rune) rune {
+		if unicode.IsSpace(character) || slices.Contains(separators, character) {
+			return -1
+		}
+
+		return character
+	}, value)
+}
@@ -0,0 +1,92 @@
+package textkey
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFolded(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "action", want: "action"},
+		{value: "Action", want: "action"},
+		{value: "bishōjo", want: "bishojo"},
+		{value: "bisho\u0304jo", want: "bishojo"},
+		{value: "Pokémon", want: "pokemon"},
+		{value: "ＲＰＧ", want: "rpg"},
+		{value: "ｶｰﾄﾞ", want: "カード"},
+		{value: "Straße", want: "strasse"},
+		{value: "beat 'em up", want: "beat 'em up"},
+		{value: "", want: ""},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.value, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := Folded(test.value); got != test.want {
+				runner.Errorf("Folded(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFoldedVariants(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "bishojo", want: []string{"bishojo"}},
+		{value: "bishoujo", want: []string{"bishoujo"}},
+		{value: "bishōjo", want: []string{"bishojo", "bishoujo"}},
+		{value: "bisho\u0304jo", want: []string{"bishojo", "bishoujo"}},
+		{value: "Ōkami", want: []string{"okami", "oukami"}},
+		{value: "Pokémon", want: []string{"pokemon"}},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.value, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := FoldedVariants(test.value); !slices.Equal(got, test.want) {
+				runner.Errorf("FoldedVariants(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithoutSeparators(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "action", want: "action"},
+		{value: "run-n-gun", want: "runngun"},
+		{value: "run 'n' gun", want: "runngun"},
+		{value: "run\u2013n\u2013gun", want: "runngun"},
+		{value: "side scroller", want: "sidescroller"},
+		{value: "side-scroller", want: "sidescroller"},
+		{value: "side \t scroller", want: "sidescroller"},
+		{value: "point-and-click", want: "pointandclick"},
+		{value: "beat ’em up", want: "beatemup"},
+		{value: "", want: ""},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.value, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := WithoutSeparators(test.value); got != test.want {
+				runner.Errorf("WithoutSeparators(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
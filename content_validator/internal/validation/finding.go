@@ -0,0 +1,271 @@
+package validation
+
+import (
+	"cmp"
+	"content_validator/internal/data"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// NameField is the Finding.Field value of findings about the name of a genre.
+const NameField = "name"
+
+// AltNameField returns the Finding.Field value of findings about an alternative name of a genre.
+//
+// Parameters:
+//
+//	altNameIndex: The index of the alternative name in the genre's AltNames slice
+//
+// Returns:
+//
+//	string: The field in the "altNames[i]" form
+//
+// Examples:
+//
+//	AltNameField(2)  // returns "altNames[2]"
+func AltNameField(altNameIndex int) string {
+	return fmt.Sprintf("altNames[%d]", altNameIndex)
+}
+
+// Finding is a single problem reported by a validation rule.
+//
+// Fields:
+//
+//	RuleID: The ID of the rule that reported the finding
+//	Severity: How serious the finding is
+//	GenreIndex: The index of the offending genre in the validated slice, or -1 if the finding is not about a genre
+//	GenreName: The name of the offending genre
+//	Field: The offending field of the genre, either NameField, the result of AltNameField or empty if the finding is
+//	not about a specific field
+//	Value: The offending value of the field
+//	Message: A human-readable explanation of the problem
+//	File: The path of the validated file, empty if unknown
+//	Position: The location of the offending value in File, the zero value if unknown
+//
+// Note:
+//
+//	Validators fill every field except RuleID and Severity, which are set by Run from the rule that reported the
+//	finding, and File and Position, which are set by LocateFindings.
+type Finding struct {
+	RuleID     string
+	Severity   Severity
+	GenreIndex int
+	GenreName  string
+	Field      string
+	Value      string
+	Message    string
+	File       string
+	Position   data.Position
+}
+
+// String formats the finding as a single human-readable line.
+//
+// Examples:
+//
+//	finding := Finding{
+//	    RuleID:     "alt-names-case",
+//	    Severity:   SeverityError,
+//	    GenreIndex: 3,
+//	    GenreName:  "action",
+//	    Field:      "altNames[0]",
+//	    Value:      "Action game",
+//	    Message:    "alternative name is not in lowercase",
+//	}
+//
+//	finding.String()
+//	// returns `error: genre #3 "action", altNames[0] "Action game": alternative name is not in lowercase [alt-names-case]`
+//
+//	finding.File = "genres.json"
+//	finding.Position = data.Position{Line: 21, Column: 4}
+//
+//	finding.String()
+//	// returns `genres.json:21:4: error: genre #3 "action", altNames[0] "Action game": ... [alt-names-case]`
+func (finding Finding) String() string {
+	line := fmt.Sprintf("%s: %s: %s [%s]", finding.Severity, finding.Subject(), finding.Message, finding.RuleID)
+
+	if finding.File != "" && finding.Position.IsKnown() {
+		line = fmt.Sprintf("%s:%d:%d: %s", finding.File, finding.Position.Line, finding.Position.Column, line)
+	}
+
+	return line
+}
+
+// Subject describes what the finding is about: the genre and, for findings about an alternative name, the field
+// and its value.
+//
+// Examples:
+//
+//	newNameFinding(0, "4X", "genre name is not in lowercase").Subject()  // returns `genre #0 "4X"`
+//
+//	newAltNameFinding(3, "action", 0, "Action game", "alternative name is not in lowercase").Subject()
+//	// returns `genre #3 "action", altNames[0] "Action game"`
+func (finding Finding) Subject() string {
+	subject := fmt.Sprintf("genre #%d %q", finding.GenreIndex, finding.GenreName)
+
+	if finding.GenreIndex < 0 {
+		subject = fmt.Sprintf("genre %q", finding.GenreName)
+	}
+
+	if finding.Field != NameField && finding.Field != "" {
+		subject = fmt.Sprintf("%s, %s %q", subject, finding.Field, finding.Value)
+	}
+
+	return subject
+}
+
+// LocateFindings sets the File and Position of the findings to where their offending values are in the document.
+//
+// Parameters:
+//
+//	findings: The findings to locate, modified in place
+//	document: The document the findings were reported for
+//
+// Examples:
+//
+//	document, _ := reader.ReadDocumentFromJSON("genres.json")
+//	findings := Run(Rules(), document.Genres, RunOptions{FailFast: false, FailOn: SeverityError, Suppressions: nil})
+//
+//	LocateFindings(findings, document)
+//
+// Note:
+//
+//	Findings about the whole genre point to the genre object. Findings that are not about a genre, or whose genre or
+//	field is not in the document, keep an unknown position but still get the File.
+func LocateFindings(findings []Finding, document data.Document) {
+	for findingIndex := range findings {
+		finding := &findings[findingIndex]
+		finding.File = document.Path
+		finding.Position = FieldPosition(document.Positions, finding.GenreIndex, finding.Field)
+	}
+}
+
+// FieldPosition returns the location of a field of a genre.
+//
+// Parameters:
+//
+//	positions: The locations of the genres, usually data.Document.Positions
+//	genreIndex: The index of the genre
+//	field: NameField, the result of AltNameField, or empty for the genre object itself
+//
+// Returns:
+//
+//	data.Position: The location of the field, or the zero value if the genre or the field is not in positions
+func FieldPosition(positions []data.GenrePositions, genreIndex int, field string) data.Position {
+	if genreIndex < 0 || genreIndex >= len(positions) {
+		return data.Position{Line: 0, Column: 0}
+	}
+
+	genrePositions := positions[genreIndex]
+
+	switch order := FieldOrder(field); {
+	case order < 0:
+		return genrePositions.Genre
+	case order == 0:
+		return genrePositions.Name
+	case order <= len(genrePositions.AltNames):
+		return genrePositions.AltNames[order-1]
+	default:
+		return data.Position{Line: 0, Column: 0}
+	}
+}
+
+func newNameFinding(genreIndex int, genreName string, message string) Finding {
+	return Finding{
+		GenreIndex: genreIndex,
+		GenreName:  genreName,
+		Field:      NameField,
+		Value:      genreName,
+		Message:    message,
+		File:       "",
+		Position:   data.Position{Line: 0, Column: 0},
+	}
+}
+
+func newAltNameFinding(genreIndex int, genreName string, altNameIndex int, altName string, message string) Finding {
+	return Finding{
+		GenreIndex: genreIndex,
+		GenreName:  genreName,
+		Field:      AltNameField(altNameIndex),
+		Value:      altName,
+		Message:    message,
+		File:       "",
+		Position:   data.Position{Line: 0, Column: 0},
+	}
+}
+
+// SortFindings sorts the findings in place in file order: by genre, then by field (the name first, then the
+// alternative names in their order). Findings of the same field keep their relative order.
+//
+// Parameters:
+//
+//	findings: The findings to sort
+//
+// Note:
+//
+//	Findings that are not about a genre (with a negative GenreIndex) are moved to the end.
+func SortFindings(findings []Finding) {
+	type keyedFinding struct {
+		genreOrder int
+		fieldOrder int
+		finding    Finding
+	}
+
+	keyedFindings := make([]keyedFinding, 0, len(findings))
+
+	for _, finding := range findings {
+		genreOrder := finding.GenreIndex
+
+		if genreOrder < 0 {
+			genreOrder = math.MaxInt
+		}
+
+		keyedFindings = append(keyedFindings, keyedFinding{
+			genreOrder: genreOrder,
+			fieldOrder: FieldOrder(finding.Field),
+			finding:    finding,
+		})
+	}
+
+	slices.SortStableFunc(keyedFindings, func(a, b keyedFinding) int {
+		if a.genreOrder != b.genreOrder {
+			return cmp.Compare(a.genreOrder, b.genreOrder)
+		}
+
+		return cmp.Compare(a.fieldOrder, b.fieldOrder)
+	})
+
+	for findingIndex, keyedFinding := range keyedFindings {
+		findings[findingIndex] = keyedFinding.finding
+	}
+}
+
+// FieldOrder returns the position of the field within a genre: the name comes first, followed by the alternative
+// names. Unknown fields, such as the empty field, come before the name.
+//
+// Examples:
+//
+//	FieldOrder(NameField)        // returns 0
+//	FieldOrder(AltNameField(2))  // returns 3
+//	FieldOrder("")               // returns -1
+func FieldOrder(field string) int {
+	if field == NameField {
+		return 0
+	}
+
+	indexText, ok := strings.CutPrefix(field, "altNames[")
+
+	if !ok {
+		return -1
+	}
+
+	altNameIndex, err := strconv.Atoi(strings.TrimSuffix(indexText, "]"))
+
+	if err != nil {
+		return -1
+	}
+
+	return altNameIndex + 1
+}
@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestSortFindings(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	findings := []Finding{
+		{RuleID: "unused-suppression", GenreIndex: -1, Field: ""},
+		{RuleID: "alt-names-case", GenreIndex: 1, Field: "altNames[10]"},
+		{RuleID: "alt-names-case", GenreIndex: 1, Field: "altNames[2]"},
+		{RuleID: "name-case", GenreIndex: 1, Field: "name"},
+		{RuleID: "name-trimmed", GenreIndex: 1, Field: "name"},
+		{RuleID: "alt-name-collision", GenreIndex: 0, Field: "altNames[0]"},
+	}
+
+	SortFindings(findings)
+
+	var got []string
+
+	for _, finding := range findings {
+		got = append(got, finding.RuleID+" "+finding.Field)
+	}
+
+	want := []string{
+		"alt-name-collision altNames[0]",
+		"name-case name",
+		"name-trimmed name",
+		"alt-names-case altNames[2]",
+		"alt-names-case altNames[10]",
+		"unused-suppression ",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		testRunner.Errorf("got order %v, want %v", got, want)
+	}
+}
+
+func TestFindingString(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name    string
+		finding Finding
+		want    string
+	}{
+		{
+			name: "name finding",
+			finding: Finding{
+				RuleID:     "name-case",
+				Severity:   SeverityError,
+				GenreIndex: 0,
+				GenreName:  "4X",
+				Field:      NameField,
+				Value:      "4X",
+				Message:    "genre name is not in lowercase",
+				File:       "",
+				Position:   data.Position{Line: 0, Column: 0},
+			},
+			want: `error: genre #0 "4X": genre name is not in lowercase [name-case]`,
+		},
+		{
+			name: "alt name finding",
+			finding: Finding{
+				RuleID:     "alt-names-case",
+				Severity:   SeverityWarning,
+				GenreIndex: 3,
+				GenreName:  "action",
+				Field:      AltNameField(0),
+				Value:      "Action game",
+				Message:    "alternative name is not in lowercase",
+				File:       "",
+				Position:   data.Position{Line: 0, Column: 0},
+			},
+			want: `warning: genre #3 "action", altNames[0] "Action game": alternative name is not in lowercase [alt-names-case]`,
+		},
+		{
+			name: "finding without genre",
+			finding: Finding{
+				RuleID:     UnusedSuppressionRuleID,
+				Severity:   SeverityWarning,
+				GenreIndex: -1,
+				GenreName:  "action",
+				Field:      "",
+				Value:      "",
+				Message:    "suppression is stale",
+				File:       "",
+				Position:   data.Position{Line: 0, Column: 0},
+			},
+			want: `warning: genre "action": suppression is stale [unused-suppression]`,
+		},
+		{
+			name: "located finding",
+			finding: Finding{
+				RuleID:     "name-case",
+				Severity:   SeverityError,
+				GenreIndex: 0,
+				GenreName:  "4X",
+				Field:      NameField,
+				Value:      "4X",
+				Message:    "genre name is not in lowercase",
+				File:       "genres.json",
+				Position:   data.Position{Line: 3, Column: 11},
+			},
+			want: `genres.json:3:11: error: genre #0 "4X": genre name is not in lowercase [name-case]`,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := test.finding.String(); got != test.want {
+				runner.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLocateFindings(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	document := data.Document{
+		Path:    "genres.json",
+		Content: nil,
+		Genres:  nil,
+		Positions: []data.GenrePositions{
+			{
+				Genre:    data.Position{Line: 2, Column: 2},
+				Name:     data.Position{Line: 3, Column: 11},
+				AltNames: []data.Position{{Line: 5, Column: 4}, {Line: 6, Column: 4}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		finding      Finding
+		wantPosition data.Position
+	}{
+		{
+			name:         "name",
+			finding:      newNameFinding(0, "action", "message"),
+			wantPosition: data.Position{Line: 3, Column: 11},
+		},
+		{
+			name:         "alt name",
+			finding:      newAltNameFinding(0, "action", 1, "fighting", "message"),
+			wantPosition: data.Position{Line: 6, Column: 4},
+		},
+		{
+			name:         "whole genre",
+			finding:      withField(newNameFinding(0, "action", "message"), ""),
+			wantPosition: data.Position{Line: 2, Column: 2},
+		},
+		{
+			name:         "unknown alt name",
+			finding:      newAltNameFinding(0, "action", 2, "fighting", "message"),
+			wantPosition: data.Position{Line: 0, Column: 0},
+		},
+		{
+			name:         "not about a genre",
+			finding:      withField(newNameFinding(-1, "action", "message"), ""),
+			wantPosition: data.Position{Line: 0, Column: 0},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			findings := []Finding{test.finding}
+
+			LocateFindings(findings, document)
+
+			if findings[0].File != document.Path {
+				runner.Errorf("got file %q, want %q", findings[0].File, document.Path)
+			}
+
+			if findings[0].Position != test.wantPosition {
+				runner.Errorf("got position %+v, want %+v", findings[0].Position, test.wantPosition)
+			}
+		})
+	}
+}
+
+func withField(finding Finding, field string) Finding {
+	finding.Field = field
+
+	return finding
+}
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/textkey"
+	"fmt"
+)
+
+func init() {
+	Register(Rule{
+		ID: "folded-name-collision",
+		Description: "Names and alternative names of different genres must not differ only by case, diacritics or " +
+			"character width",
+		Hint:            "keep the spellings of the name in only one of the genres",
+		Category:        CategoryCollision,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateFoldedCollisions),
+	})
+}
+
+// ValidateFoldedCollisions checks if a name or an alternative name of a genre only differs from a name or an
+// alternative name of another genre by case, diacritics or character width, so the genres are the same genre spelled
+// differently.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every value with a folded variant in another genre, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "bishōjo", AltNames: []string{"bishojo", "bishoujo"}},
+//	}
+//
+//	ValidateFoldedCollisions(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "bishōjo", AltNames: []string{}},
+//	    {Name: "bishoujo", AltNames: []string{}},
+//	}
+//
+//	ValidateFoldedCollisions(invalidGenres)  // returns findings for the names of "bishōjo" and "bishoujo"
+//
+// Note:
+//
+//	Values are compared by their folded variants (see textkey.FoldedVariants), so a long vowel written with a macron
+//	also matches the vowel spelled out ("ō" and "ou").
+//	Equal values are not reported, they are the collisions found by the uniqueness and collision rules.
+//	Every colliding value is reported once, with the first value it collides with.
+//	The findings are in file order.
+func ValidateFoldedCollisions(genres []data.GameGenre) []Finding {
+	return keyCollisions(genres, textkey.FoldedVariants, func(value namedValue, otherValue namedValue) string {
+		return fmt.Sprintf("%s only differs by case, diacritics or character width from %s", value.kind(),
+			otherValue.describe(genres))
+	})
+}
@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateFoldedCollisions(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantMessages: nil,
+		},
+		{
+			name: "variants within a genre",
+			genres: []data.GameGenre{
+				{Name: "bishōjo", AltNames: []string{"bishojo", "bishoujo", "Bishōjo"}},
+				{Name: "rpg", AltNames: []string{"RPG"}},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "diacritics and romanization",
+			genres: []data.GameGenre{
+				{Name: "bishōjo", AltNames: []string{}},
+				{Name: "bishojo", AltNames: []string{}},
+				{Name: "bishoujo", AltNames: []string{}},
+			},
+			wantMessages: []string{
+				`genre #0 "bishōjo": genre name only differs by case, diacritics or character width from the name ` +
+					`of genre "bishojo"`,
+				`genre #1 "bishojo": genre name only differs by case, diacritics or character width from the name ` +
+					`of genre "bishōjo"`,
+				`genre #2 "bishoujo": genre name only differs by case, diacritics or character width from the ` +
+					`name of genre "bishōjo"`,
+			},
+		},
+		{
+			name: "case and width",
+			genres: []data.GameGenre{
+				{Name: "role-playing", AltNames: []string{"RPG"}},
+				{Name: "action rpg", AltNames: []string{"ａｒｐｇ", "ｒｐｇ"}},
+			},
+			wantMessages: []string{
+				`genre #0 "role-playing", altNames[0] "RPG": alternative name only differs by case, diacritics or ` +
+					`character width from alternative name "ｒｐｇ" of genre "action rpg"`,
+				`genre #1 "action rpg", altNames[1] "ｒｐｇ": alternative name only differs by case, diacritics or ` +
+					`character width from alternative name "RPG" of genre "role-playing"`,
+			},
+		},
+		{
+			name: "equal values are left to the collision rules",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"fighting"}},
+				{Name: "beat 'em up", AltNames: []string{"fighting"}},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "genres with the same name",
+			genres: []data.GameGenre{
+				{Name: "rpg", AltNames: []string{}},
+				{Name: "rpg", AltNames: []string{"RPG"}},
+			},
+			wantMessages: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotMessages := messagesOf(ValidateFoldedCollisions(test.genres))
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
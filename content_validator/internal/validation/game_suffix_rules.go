@@ -0,0 +1,188 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/inflection"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// gameSuffixOption is the suffix the game suffix rules enforce the convention for.
+	gameSuffixOption = "suffix"
+
+	// gameSuffixExceptionsOption lists the genre names the game suffix rules do not report.
+	gameSuffixExceptionsOption = "exceptions"
+
+	// gameWordsOption lists the words that already stand for a game, the game-suffix-alt-name rule does not report
+	// genres whose name ends with one of them.
+	gameWordsOption = "gameWords"
+
+	defaultGameSuffix = " game"
+)
+
+// defaultGameWords are the acronyms and words of genres.json whose last letters stand for "game" ("rpg" is a
+// role-playing game, "eroge" is an erotic game).
+var defaultGameWords = []string{
+	"arpg", "crpg", "dccg", "drpg", "eroge", "gsg", "jrpg", "kusoge", "mmorpg", "rpg", "stg",
+}
+
+func init() {
+	Register(Rule{
+		ID:              "name-game-suffix",
+		Description:     "Genre names must not end with the \" game\" suffix",
+		Hint:            "rename the genre without the suffix and keep the suffixed name as an alternative name",
+		Category:        CategoryConvention,
+		DefaultSeverity: SeverityInfo,
+		Options:         Options{gameSuffixOption: defaultGameSuffix, gameSuffixExceptionsOption: []string{}},
+		Check:           checkNameGameSuffix,
+	})
+
+	Register(Rule{
+		ID:              "game-suffix-alt-name",
+		Description:     "Genres should have their name with the \" game\" suffix as an alternative name",
+		Hint:            "add the suggested alternative name, or list the genre in the \"exceptions\" option",
+		Category:        CategoryConvention,
+		DefaultSeverity: SeverityInfo,
+		Options: Options{
+			gameSuffixOption:           defaultGameSuffix,
+			gameSuffixExceptionsOption: []string{},
+			gameWordsOption:            slices.Clone(defaultGameWords),
+		},
+		Check:    checkGameSuffixAltName,
+		Disabled: true,
+	})
+}
+
+func checkNameGameSuffix(dataset *Dataset, options Options) []Finding {
+	return ValidateNameGameSuffix(dataset.Genres, options.String(gameSuffixOption),
+		options.Strings(gameSuffixExceptionsOption))
+}
+
+func checkGameSuffixAltName(dataset *Dataset, options Options) []Finding {
+	return validateGameSuffixAltName(dataset, options.String(gameSuffixOption),
+		options.Strings(gameSuffixExceptionsOption), options.Strings(gameWordsOption))
+}
+
+// ValidateNameGameSuffix checks if any game genre name ends with the suffix, since the convention is to use the name
+// without the suffix and to keep the suffixed name as an alternative name.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//	suffix: The suffix names must not end with (e.g. " game"), nothing is reported if it is empty
+//	exceptions: The genre names that are not reported even if they end with the suffix
+//
+// Returns:
+//
+//	[]Finding: A finding for every genre whose name ends with the suffix, or nil if none found
+//
+// Examples:
+//
+//	genres := []data.GameGenre{
+//	    {Name: "action", AltNames: []string{"action game"}},
+//	    {Name: "card game", AltNames: []string{}},
+//	}
+//
+//	ValidateNameGameSuffix(genres, " game", nil)                    // returns a finding for the name of "card game"
+//	ValidateNameGameSuffix(genres, " game", []string{"card game"})  // returns nil
+//
+// Note:
+//
+//	The name without the suffix is only suggested if it is an alternative name of the genre, since stripping the
+//	suffix does not always leave a genre name ("card game" would become "card").
+func ValidateNameGameSuffix(genres []data.GameGenre, suffix string, exceptions []string) []Finding {
+	if suffix == "" {
+		return nil
+	}
+
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
+		unsuffixedName, ok := strings.CutSuffix(genre.Name, suffix)
+
+		if !ok || unsuffixedName == "" || slices.Contains(exceptions, genre.Name) {
+			continue
+		}
+
+		message := fmt.Sprintf("genre name ends with %q", suffix)
+
+		if slices.Contains(genre.AltNames, unsuffixedName) {
+			message += fmt.Sprintf(", the name should be its alternative name %q with %q as an alternative name",
+				unsuffixedName, genre.Name)
+		}
+
+		findings = append(findings, newNameFinding(genreIndex, genre.Name, message))
+	}
+
+	return findings
+}
+
+// ValidateGameSuffixAltName checks if every game genre has its name with the suffix as an alternative name, so the
+// genre can be found by both forms.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//	suffix: The suffix of the expected alternative name (e.g. " game"), nothing is reported if it is empty
+//	exceptions: The genre names that are not reported even if they have no suffixed alternative name
+//	gameWords: The words that already stand for a game (e.g. "rpg"), genres whose name ends with one are not reported
+//
+// Returns:
+//
+//	[]Finding: A finding suggesting the missing alternative name for every genre without it, or nil if none found
+//
+// Examples:
+//
+//	genres := []data.GameGenre{
+//	    {Name: "action", AltNames: []string{"action game"}},
+//	    {Name: "racing", AltNames: []string{}},
+//	    {Name: "wargame", AltNames: []string{}},
+//	    {Name: "tactical rpg", AltNames: []string{}},
+//	}
+//
+//	ValidateGameSuffixAltName(genres, " game", nil, []string{"rpg"})
+//	// returns a finding suggesting "racing game" for "racing"
+//
+// Note:
+//
+//	Genres whose name already ends with the suffix, or with the suffix without its leading spaces ("wargame",
+//	"mini-game"), are not reported, and neither are genres whose name is in the plural form ("text adventures"),
+//	since they name a group of games rather than a game.
+//	No alternative name is suggested if it is already used by any genre, since adding it would cause a collision.
+//	The rule is opt-in, since most genres of genres.json have no suffixed alternative name.
+func ValidateGameSuffixAltName(genres []data.GameGenre, suffix string, exceptions []string,
+	gameWords []string) []Finding {
+	return validateGameSuffixAltName(NewDataset(genres), suffix, exceptions, gameWords)
+}
+
+func validateGameSuffixAltName(dataset *Dataset, suffix string, exceptions []string, gameWords []string) []Finding {
+	trimmedSuffix := strings.TrimSpace(suffix)
+
+	if trimmedSuffix == "" {
+		return nil
+	}
+
+	var findings []Finding
+
+	for genreIndex, genre := range dataset.Genres {
+		lastWord := inflection.LastWord(genre.Name)
+
+		if strings.HasSuffix(genre.Name, trimmedSuffix) || slices.Contains(exceptions, genre.Name) ||
+			slices.Contains(gameWords, lastWord) || inflection.IsPlural(lastWord) {
+			continue
+		}
+
+		suffixedName := genre.Name + suffix
+
+		if len(dataset.Index().GenreIndexes(suffixedName)) > 0 || len(dataset.Index().AltNameRefs(suffixedName)) > 0 {
+			continue
+		}
+
+		findings = append(findings, newNameFinding(genreIndex, genre.Name,
+			fmt.Sprintf("genre has no alternative name %q, consider adding it", suffixedName)))
+	}
+
+	return findings
+}
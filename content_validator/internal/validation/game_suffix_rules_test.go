@@ -0,0 +1,239 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateNameGameSuffix(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		suffix       string
+		exceptions   []string
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			wantFindings: nil,
+		},
+		{
+			name: "names without the suffix",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"action game"}},
+				{Name: "wargame", AltNames: nil},
+				{Name: "game", AltNames: nil},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			wantFindings: nil,
+		},
+		{
+			name: "names with the suffix",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"action game"}},
+				{Name: "card game", AltNames: nil},
+				{Name: "kart racing game", AltNames: []string{"kart racer"}},
+			},
+			suffix:     defaultGameSuffix,
+			exceptions: nil,
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "card game"},
+				{genreIndex: 2, field: "name", value: "kart racing game"},
+			},
+		},
+		{
+			name: "exceptions",
+			genres: []data.GameGenre{
+				{Name: "card game", AltNames: nil},
+				{Name: "serious game", AltNames: nil},
+			},
+			suffix:     defaultGameSuffix,
+			exceptions: []string{"card game"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "serious game"},
+			},
+		},
+		{
+			name: "other suffix",
+			genres: []data.GameGenre{
+				{Name: "card game", AltNames: nil},
+				{Name: "walking simulator", AltNames: nil},
+			},
+			suffix:     " simulator",
+			exceptions: nil,
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "walking simulator"},
+			},
+		},
+		{
+			name: "empty suffix",
+			genres: []data.GameGenre{
+				{Name: "card game", AltNames: nil},
+			},
+			suffix:       "",
+			exceptions:   nil,
+			wantFindings: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateNameGameSuffix(test.genres, test.suffix, test.exceptions))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("ValidateNameGameSuffix() = %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestValidateNameGameSuffixMessages(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{
+		{Name: "card game", AltNames: nil},
+		{Name: "shoot 'em up game", AltNames: []string{"shmup", "shoot 'em up"}},
+	}
+
+	wantMessages := []string{
+		`genre #0 "card game": genre name ends with " game"`,
+		`genre #1 "shoot 'em up game": genre name ends with " game", the name should be its alternative name ` +
+			`"shoot 'em up" with "shoot 'em up game" as an alternative name`,
+	}
+
+	gotMessages := messagesOf(ValidateNameGameSuffix(genres, defaultGameSuffix, nil))
+
+	if !reflect.DeepEqual(gotMessages, wantMessages) {
+		testRunner.Errorf("ValidateNameGameSuffix() messages = %q, want %q", gotMessages, wantMessages)
+	}
+}
+
+func TestValidateGameSuffixAltName(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		suffix       string
+		exceptions   []string
+		gameWords    []string
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: nil,
+		},
+		{
+			name: "suffixed alt names present",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"action game"}},
+				{Name: "party", AltNames: []string{"party game", "party video game"}},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: nil,
+		},
+		{
+			name: "suffixed alt name missing",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"action game"}},
+				{Name: "racing", AltNames: []string{"racer"}},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: []string{`genre has no alternative name "racing game", consider adding it`},
+		},
+		{
+			name: "names ending with the suffix",
+			genres: []data.GameGenre{
+				{Name: "card game", AltNames: nil},
+				{Name: "wargame", AltNames: nil},
+				{Name: "mini-game", AltNames: nil},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: nil,
+		},
+		{
+			name: "suffixed name used by another genre",
+			genres: []data.GameGenre{
+				{Name: "rhythm", AltNames: []string{"music game", "rhythm game"}},
+				{Name: "music", AltNames: nil},
+				{Name: "card", AltNames: nil},
+				{Name: "card game", AltNames: nil},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: nil,
+		},
+		{
+			name: "plural names and game words",
+			genres: []data.GameGenre{
+				{Name: "text adventures", AltNames: nil},
+				{Name: "girls' video games", AltNames: nil},
+				{Name: "tactical rpg", AltNames: nil},
+				{Name: "kusoge", AltNames: nil},
+				{Name: "racing", AltNames: nil},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   nil,
+			gameWords:    defaultGameWords,
+			wantMessages: []string{`genre has no alternative name "racing game", consider adding it`},
+		},
+		{
+			name: "exceptions",
+			genres: []data.GameGenre{
+				{Name: "fps", AltNames: nil},
+				{Name: "racing", AltNames: nil},
+			},
+			suffix:       defaultGameSuffix,
+			exceptions:   []string{"fps"},
+			gameWords:    nil,
+			wantMessages: []string{`genre has no alternative name "racing game", consider adding it`},
+		},
+		{
+			name: "empty suffix",
+			genres: []data.GameGenre{
+				{Name: "racing", AltNames: nil},
+			},
+			suffix:       " ",
+			exceptions:   nil,
+			gameWords:    nil,
+			wantMessages: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			var gotMessages []string
+
+			for _, finding := range ValidateGameSuffixAltName(test.genres, test.suffix, test.exceptions,
+				test.gameWords) {
+				gotMessages = append(gotMessages, finding.Message)
+			}
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+)
+
+// AltNameRef points to an alternative name of a genre.
+//
+// Fields:
+//
+//	GenreIndex: The index of the genre in the indexed slice
+//	AltNameIndex: The index of the alternative name in the genre's AltNames slice
+type AltNameRef struct {
+	GenreIndex   int
+	AltNameIndex int
+}
+
+// Index maps genre names and alternative names to the places they occur at, so rules that compare names across
+// genres run in linear time instead of comparing every genre with every other genre.
+type Index struct {
+	genreIndexesByName   map[string][]int
+	altNameRefsByAltName map[string][]AltNameRef
+}
+
+// NewIndex builds the index of the given genres.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to index
+//
+// Returns:
+//
+//	*Index: The index of the genres
+//
+// Examples:
+//
+//	index := NewIndex([]data.GameGenre{
+//	    {Name: "action", AltNames: []string{"fighting"}},
+//	    {Name: "beat 'em up", AltNames: []string{"brawler", "fighting"}},
+//	})
+//
+//	index.GenreIndexes("action")  // returns []int{0}
+//	index.AltNameRefs("fighting")  // returns []AltNameRef{{0, 0}, {1, 1}}
+//
+// Note:
+//
+//	The index is a snapshot, it does not reflect later changes of the genres.
+func NewIndex(genres []data.GameGenre) *Index {
+	index := &Index{
+		genreIndexesByName:   make(map[string][]int, len(genres)),
+		altNameRefsByAltName: make(map[string][]AltNameRef, len(genres)),
+	}
+
+	for genreIndex, genre := range genres {
+		index.genreIndexesByName[genre.Name] = append(index.genreIndexesByName[genre.Name], genreIndex)
+
+		for altNameIndex, altName := range genre.AltNames {
+			index.altNameRefsByAltName[altName] = append(index.altNameRefsByAltName[altName], AltNameRef{
+				GenreIndex:   genreIndex,
+				AltNameIndex: altNameIndex,
+			})
+		}
+	}
+
+	return index
+}
+
+// GenreIndexes returns the indexes of the genres with the given name, in ascending order, or nil if there are none.
+func (index *Index) GenreIndexes(name string) []int {
+	return index.genreIndexesByName[name]
+}
+
+// AltNameRefs returns the places where the given alternative name occurs, in file order, or nil if there are none.
+func (index *Index) AltNameRefs(altName string) []AltNameRef {
+	return index.altNameRefsByAltName[altName]
+}
+
+// Dataset is the input of a rule: the validated genres and lazily built shared lookup structures.
+//
+// Fields:
+//
+//	Genres: The validated genres
+type Dataset struct {
+	Genres []data.GameGenre
+
+	index *Index
+}
+
+// NewDataset wraps the genres for validation.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	*Dataset: The dataset, shared by all rules of a run
+func NewDataset(genres []data.GameGenre) *Dataset {
+	return &Dataset{Genres: genres, index: nil}
+}
+
+// Index returns the index of the dataset's genres. The index is built on the first call and reused afterwards.
+func (dataset *Dataset) Index() *Index {
+	if dataset.index == nil {
+		dataset.index = NewIndex(dataset.Genres)
+	}
+
+	return dataset.index
+}
@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNewIndex(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	index := NewIndex([]data.GameGenre{
+		{Name: "action", AltNames: []string{"fighting"}},
+		{Name: "beat 'em up", AltNames: []string{"brawler", "fighting"}},
+		{Name: "action", AltNames: nil},
+	})
+
+	tests := []struct {
+		name            string
+		lookup          string
+		wantGenres      []int
+		wantAltNameRefs []AltNameRef
+	}{
+		{
+			name:            "repeated genre name",
+			lookup:          "action",
+			wantGenres:      []int{0, 2},
+			wantAltNameRefs: nil,
+		},
+		{
+			name:            "shared alt name",
+			lookup:          "fighting",
+			wantGenres:      nil,
+			wantAltNameRefs: []AltNameRef{{GenreIndex: 0, AltNameIndex: 0}, {GenreIndex: 1, AltNameIndex: 1}},
+		},
+		{
+			name:            "unknown name",
+			lookup:          "racing",
+			wantGenres:      nil,
+			wantAltNameRefs: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := index.GenreIndexes(test.lookup); !reflect.DeepEqual(got, test.wantGenres) {
+				runner.Errorf("got genre indexes %v, want %v", got, test.wantGenres)
+			}
+
+			if got := index.AltNameRefs(test.lookup); !reflect.DeepEqual(got, test.wantAltNameRefs) {
+				runner.Errorf("got alt name refs %v, want %v", got, test.wantAltNameRefs)
+			}
+		})
+	}
+}
+
+// benchmarkSizes are the dataset sizes the benchmarks run with. The time per operation must grow linearly with them.
+var benchmarkSizes = []int{1_000, 10_000, 100_000}
+
+// syntheticGenres generates a dataset where every hundredth genre collides with its neighbour, so the collision
+// rules have some findings to report.
+func syntheticGenres(count int) []data.GameGenre {
+	const collisionPeriod = 100
+
+	genres := make([]data.GameGenre, 0, count)
+
+	for genreIndex := range count {
+		name := syntheticGenreName(genreIndex)
+		altNames := []string{name + " game", name + " style"}
+
+		if genreIndex%collisionPeriod == 1 {
+			previousName := syntheticGenreName(genreIndex - 1)
+			altNames = append(altNames, previousName, previousName+" game")
+		}
+
+		genres = append(genres, data.GameGenre{Name: name, AltNames: altNames})
+	}
+
+	return genres
+}
+
+// syntheticGenreName returns a different name for every genre index. The names differ in several letters, so the
+// genres are not near duplicates of each other.
+func syntheticGenreName(genreIndex int) string {
+	const (
+		letters    = 26
+		codeLength = 8
+		multiplier = 2_654_435_761 // coprime with 26, so different indexes get different codes
+	)
+
+	value := uint64(genreIndex) * multiplier
+	code := make([]byte, codeLength)
+
+	for position := range code {
+		code[position] = byte('a' + value%letters)
+		value /= letters
+	}
+
+	return "genre " + string(code)
+}
+
+func benchmarkValidator(benchmarkRunner *testing.B, validate func(genres []data.GameGenre) []Finding) {
+	for _, size := range benchmarkSizes {
+		genres := syntheticGenres(size)
+
+		benchmarkRunner.Run(fmt.Sprintf("genres=%d", size), func(runner *testing.B) {
+			for range runner.N {
+				validate(genres)
+			}
+		})
+	}
+}
+
+func BenchmarkValidateNameUnique(benchmarkRunner *testing.B) {
+	benchmarkValidator(benchmarkRunner, ValidateNameUnique)
+}
+
+func BenchmarkValidateGenreNameNoCollisionsWithAltNames(benchmarkRunner *testing.B) {
+	benchmarkValidator(benchmarkRunner, ValidateGenreNameNoCollisionsWithAltNames)
+}
+
+func BenchmarkValidateCollidingAltNames(benchmarkRunner *testing.B) {
+	benchmarkValidator(benchmarkRunner, ValidateCollidingAltNames)
+}
+
+func BenchmarkRun(benchmarkRunner *testing.B) {
+	benchmarkValidator(benchmarkRunner, func(genres []data.GameGenre) []Finding {
+		return Run(Rules(), genres, RunOptions{FailFast: false, FailOn: SeverityError, Suppressions: nil})
+	})
+}
@@ -0,0 +1,150 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/inflection"
+	"fmt"
+	"slices"
+)
+
+// pluralExceptionsOption lists the words the inflection rules accept even though they look like plurals.
+const pluralExceptionsOption = "exceptions"
+
+// defaultPluralExceptions are the words of genres.json that end like plurals but are not: nouns that are only used in
+// the plural form, and acronyms whose last letter stands for a singular noun ("fps" is a first-person shooter).
+var defaultPluralExceptions = []string{
+	"cms", "esports", "fps", "mmofps", "mmorts", "mmotbs", "physics", "rts", "sports", "tactics", "tbs", "tps",
+}
+
+func init() {
+	Register(Rule{
+		ID:          "name-singular",
+		Description: "Genre names must be in the singular form",
+		Hint: "use the singular form as the name and the plural form as an alternative name, or list the word in " +
+			"the \"exceptions\" option if it is not a plural",
+		Category:        CategoryInflection,
+		DefaultSeverity: SeverityWarning,
+		Options:         Options{pluralExceptionsOption: slices.Clone(defaultPluralExceptions)},
+		Check:           checkNameSingular,
+	})
+
+	Register(Rule{
+		ID:              "singular-plural-collision",
+		Description:     "Different genres must not use the singular and the plural form of the same name",
+		Hint:            "keep the singular and the plural form in only one of the genres",
+		Category:        CategoryCollision,
+		DefaultSeverity: SeverityError,
+		Options:         Options{pluralExceptionsOption: slices.Clone(defaultPluralExceptions)},
+		Check:           checkSingularPluralCollisions,
+	})
+}
+
+func checkNameSingular(dataset *Dataset, options Options) []Finding {
+	return ValidateNameSingular(dataset.Genres, options.Strings(pluralExceptionsOption))
+}
+
+// ValidateNameSingular checks if all game genre names are in the singular form, by the form of their last word.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//	exceptions: The words that are not reported even if they look like plurals (e.g. "sports")
+//
+// Returns:
+//
+//	[]Finding: A finding for every genre whose name is in the plural form, or nil if none found
+//
+// Examples:
+//
+//	genres := []data.GameGenre{
+//	    {Name: "text adventures", AltNames: []string{"interactive fiction"}},
+//	    {Name: "sports", AltNames: []string{}},
+//	    {Name: "visual novel", AltNames: []string{}},
+//	}
+//
+//	ValidateNameSingular(genres, []string{"sports"})  // returns a finding for the name of "text adventures"
+//
+// Note:
+//
+//	Only the last word of a name is checked, since it is the noun of the name in English ("girls' video games").
+func ValidateNameSingular(genres []data.GameGenre, exceptions []string) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
+		lastWord := inflection.LastWord(genre.Name)
+
+		if slices.Contains(exceptions, lastWord) || !inflection.IsPlural(lastWord) {
+			continue
+		}
+
+		findings = append(findings, newNameFinding(genreIndex, genre.Name,
+			fmt.Sprintf("genre name is in the plural form, the singular form is %q",
+				inflection.SingularPhrase(genre.Name))))
+	}
+
+	return findings
+}
+
+func checkSingularPluralCollisions(dataset *Dataset, options Options) []Finding {
+	return ValidateSingularPluralCollisions(dataset.Genres, options.Strings(pluralExceptionsOption))
+}
+
+// ValidateSingularPluralCollisions checks if a name or an alternative name of a genre is the singular or the plural
+// form of a name or an alternative name of another genre.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//	exceptions: The words that are kept as they are even if they look like plurals (e.g. "sports")
+//
+// Returns:
+//
+//	[]Finding: A finding for every value with a singular or plural variant in another genre, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "shooter", AltNames: []string{"shooters"}},
+//	}
+//
+//	ValidateSingularPluralCollisions(validGenres, nil)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "text adventure", AltNames: []string{}},
+//	    {Name: "text adventures", AltNames: []string{}},
+//	}
+//
+//	ValidateSingularPluralCollisions(invalidGenres, nil)
+//	// returns findings for the names of "text adventure" and "text adventures"
+//
+// Note:
+//
+//	Values are compared by the singular form of their last word (see inflection.SingularPhrase), unless the last
+//	word is an exception, so "sports" does not collide with "sport".
+//	Equal values are not reported, they are the collisions found by the uniqueness and collision rules.
+//	Every colliding value is reported once, with the first value it collides with.
+//	The findings are in file order.
+func ValidateSingularPluralCollisions(genres []data.GameGenre, exceptions []string) []Finding {
+	singularByLastWord := make(map[string]string)
+
+	singularKeys := func(value string) []string {
+		lastWord := inflection.LastWord(value)
+		singular, ok := singularByLastWord[lastWord]
+
+		if !ok {
+			singular = lastWord
+
+			if !slices.Contains(exceptions, lastWord) {
+				singular = inflection.Singular(lastWord)
+			}
+
+			singularByLastWord[lastWord] = singular
+		}
+
+		return []string{value[:len(value)-len(lastWord)] + singular}
+	}
+
+	return keyCollisions(genres, singularKeys, func(value namedValue, otherValue namedValue) string {
+		return fmt.Sprintf("%s is a singular or plural form of %s", value.kind(), otherValue.describe(genres))
+	})
+}
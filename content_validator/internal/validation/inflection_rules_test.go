@@ -0,0 +1,171 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateNameSingular(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		exceptions   []string
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			exceptions:   nil,
+			wantFindings: nil,
+		},
+		{
+			name: "singular names",
+			genres: []data.GameGenre{
+				{Name: "visual novel", AltNames: []string{"visual novels"}},
+				{Name: "wargame", AltNames: nil},
+				{Name: "series", AltNames: nil},
+			},
+			exceptions:   nil,
+			wantFindings: nil,
+		},
+		{
+			name: "plural names",
+			genres: []data.GameGenre{
+				{Name: "girls' video games", AltNames: nil},
+				{Name: "strategy", AltNames: nil},
+				{Name: "text adventures", AltNames: nil},
+			},
+			exceptions: nil,
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "girls' video games"},
+				{genreIndex: 2, field: "name", value: "text adventures"},
+			},
+		},
+		{
+			name: "exceptions",
+			genres: []data.GameGenre{
+				{Name: "sports", AltNames: nil},
+				{Name: "fps", AltNames: nil},
+				{Name: "text adventures", AltNames: nil},
+			},
+			exceptions: defaultPluralExceptions,
+			wantFindings: []findingLocation{
+				{genreIndex: 2, field: "name", value: "text adventures"},
+			},
+		},
+		{
+			name: "exceptions match the last word",
+			genres: []data.GameGenre{
+				{Name: "extreme sports", AltNames: nil},
+			},
+			exceptions:   []string{"sports"},
+			wantFindings: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateNameSingular(test.genres, test.exceptions))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("ValidateNameSingular() = %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestValidateSingularPluralCollisions(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		exceptions   []string
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			exceptions:   nil,
+			wantMessages: nil,
+		},
+		{
+			name: "variants within a genre",
+			genres: []data.GameGenre{
+				{Name: "sports", AltNames: []string{"sport"}},
+				{Name: "shooter", AltNames: []string{"shooters"}},
+			},
+			exceptions:   nil,
+			wantMessages: nil,
+		},
+		{
+			name: "plural of a genre name",
+			genres: []data.GameGenre{
+				{Name: "text adventure", AltNames: []string{}},
+				{Name: "text adventures", AltNames: []string{"interactive fiction"}},
+			},
+			exceptions: nil,
+			wantMessages: []string{
+				`genre #0 "text adventure": genre name is a singular or plural form of the name of genre ` +
+					`"text adventures"`,
+				`genre #1 "text adventures": genre name is a singular or plural form of the name of genre ` +
+					`"text adventure"`,
+			},
+		},
+		{
+			name: "plural of an alt name",
+			genres: []data.GameGenre{
+				{Name: "rpg", AltNames: []string{"role-playing game", "role-playing games"}},
+				{Name: "strategy", AltNames: []string{"strategy games"}},
+				{Name: "wargame", AltNames: []string{"role-playing games", "strategy game"}},
+			},
+			exceptions: nil,
+			wantMessages: []string{
+				`genre #0 "rpg", altNames[0] "role-playing game": alternative name is a singular or plural form ` +
+					`of alternative name "role-playing games" of genre "wargame"`,
+				`genre #1 "strategy", altNames[0] "strategy games": alternative name is a singular or plural form ` +
+					`of alternative name "strategy game" of genre "wargame"`,
+				`genre #2 "wargame", altNames[0] "role-playing games": alternative name is a singular or plural ` +
+					`form of alternative name "role-playing game" of genre "rpg"`,
+				`genre #2 "wargame", altNames[1] "strategy game": alternative name is a singular or plural form ` +
+					`of alternative name "strategy games" of genre "strategy"`,
+			},
+		},
+		{
+			name: "genres with the same name",
+			genres: []data.GameGenre{
+				{Name: "shooter", AltNames: []string{}},
+				{Name: "shooter", AltNames: []string{"shooters"}},
+			},
+			exceptions:   nil,
+			wantMessages: nil,
+		},
+		{
+			name: "exceptions are not singularized",
+			genres: []data.GameGenre{
+				{Name: "sports", AltNames: []string{}},
+				{Name: "tactics", AltNames: []string{}},
+				{Name: "sport", AltNames: []string{"tactic"}},
+			},
+			exceptions:   defaultPluralExceptions,
+			wantMessages: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotMessages := messagesOf(ValidateSingularPluralCollisions(test.genres, test.exceptions))
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package validation
+
+import (
+	"cmp"
+	"content_validator/internal/data"
+	"fmt"
+	"slices"
+)
+
+// namedValue is a genre name or an alternative name, with the place it occurs at. It lets rules that compare names
+// and alternative names with each other treat both the same way.
+type namedValue struct {
+	genreIndex   int
+	altNameIndex int // -1 for the genre name
+	value        string
+}
+
+func (value namedValue) isName() bool {
+	return value.altNameIndex < 0
+}
+
+// kind returns how messages refer to the value: "genre name" or "alternative name".
+func (value namedValue) kind() string {
+	if value.isName() {
+		return "genre name"
+	}
+
+	return "alternative name"
+}
+
+// describe returns how messages about another value refer to this value, e.g. `the name of genre "action"`.
+func (value namedValue) describe(genres []data.GameGenre) string {
+	genreName := genres[value.genreIndex].Name
+
+	if value.isName() {
+		return fmt.Sprintf("the name of genre %q", genreName)
+	}
+
+	return fmt.Sprintf("alternative name %q of genre %q", value.value, genreName)
+}
+
+// finding returns a finding about the value with the given message.
+func (value namedValue) finding(genres []data.GameGenre, message string) Finding {
+	genreName := genres[value.genreIndex].Name
+
+	if value.isName() {
+		return newNameFinding(value.genreIndex, genreName, message)
+	}
+
+	return newAltNameFinding(value.genreIndex, genreName, value.altNameIndex, value.value, message)
+}
+
+// compareOrder orders values in file order.
+func compareOrder(a namedValue, b namedValue) int {
+	if a.genreIndex != b.genreIndex {
+		return cmp.Compare(a.genreIndex, b.genreIndex)
+	}
+
+	return cmp.Compare(a.altNameIndex, b.altNameIndex)
+}
+
+// namedValuesOf lists the names and alternative names of the genres in file order.
+func namedValuesOf(genres []data.GameGenre) []namedValue {
+	var values []namedValue
+
+	for genreIndex, genre := range genres {
+		values = append(values, namedValue{
+			genreIndex:   genreIndex,
+			altNameIndex: -1,
+			value:        genre.Name,
+		})
+
+		for altNameIndex, altName := range genre.AltNames {
+			values = append(values, namedValue{
+				genreIndex:   genreIndex,
+				altNameIndex: altNameIndex,
+				value:        altName,
+			})
+		}
+	}
+
+	return values
+}
+
+// keyCollisions reports the values that share a comparison key with a different value of another genre, e.g. the
+// singular form of the value.
+//
+// Parameters:
+//
+//	genres: The genres to check
+//	keysOf: Returns the comparison keys of a value, values collide if they have a key in common
+//	message: Returns the message of the finding about value that collides with other
+//
+// Returns:
+//
+//	[]Finding: A finding for every colliding value, or nil if none found
+//
+// Note:
+//
+//	Equal values are not reported, they are the collisions found by the uniqueness and collision rules, and neither
+//	are the values of genres with the same name, since such genres are already reported as duplicates.
+//	Every colliding value is reported once, with the first value in file order it collides with, so a group of
+//	colliding values produces one finding per value rather than one per pair.
+//	The findings are in file order.
+func keyCollisions(genres []data.GameGenre, keysOf func(value string) []string,
+	message func(value namedValue, other namedValue) string) []Finding {
+	values := namedValuesOf(genres)
+	valueIndexesByKey := make(map[string][]int, len(values))
+
+	for valueIndex, value := range values {
+		for _, key := range keysOf(value.value) {
+			valueIndexesByKey[key] = append(valueIndexesByKey[key], valueIndex)
+		}
+	}
+
+	otherValueIndexes := make([]int, len(values))
+
+	for valueIndex := range otherValueIndexes {
+		otherValueIndexes[valueIndex] = -1
+	}
+
+	genreNameOf := func(valueIndex int) string { return genres[values[valueIndex].genreIndex].Name }
+
+	for _, valueIndexes := range valueIndexesByKey {
+		// NOTE: equal values collide with the same values, so every value is only compared with the first occurrence
+		// of every other value, and with its first occurrence in a genre with another name than that one. A key
+		// shared by many equal values then costs as much as a key shared by a few distinct values.
+		occurrences := firstOccurrencesOf(valueIndexes, values, genreNameOf)
+
+		for _, valueIndex := range valueIndexes {
+			for _, occurrence := range occurrences {
+				otherValueIndex := occurrence.first
+
+				if genreNameOf(otherValueIndex) == genreNameOf(valueIndex) {
+					otherValueIndex = occurrence.firstInOtherGenre
+				}
+
+				if values[occurrence.first].value == values[valueIndex].value || otherValueIndex < 0 {
+					continue
+				}
+
+				if otherValueIndexes[valueIndex] < 0 || otherValueIndex < otherValueIndexes[valueIndex] {
+					otherValueIndexes[valueIndex] = otherValueIndex
+				}
+			}
+		}
+	}
+
+	var findings []Finding
+
+	for valueIndex, value := range values {
+		if otherValueIndex := otherValueIndexes[valueIndex]; otherValueIndex >= 0 {
+			findings = append(findings, value.finding(genres, message(value, values[otherValueIndex])))
+		}
+	}
+
+	return findings
+}
+
+// valueOccurrences are the first occurrences of a value among the values of a comparison key.
+//
+// Fields:
+//
+//	first: The index of the first occurrence of the value
+//	firstInOtherGenre: The index of the first occurrence in a genre with another name than the genre of first, -1 if
+//	there is none
+type valueOccurrences struct {
+	first             int
+	firstInOtherGenre int
+}
+
+// firstOccurrencesOf returns the first occurrences of every distinct value of the values of a key, in the order the
+// values first occur.
+func firstOccurrencesOf(valueIndexes []int, values []namedValue,
+	genreNameOf func(valueIndex int) string) []valueOccurrences {
+	var occurrences []valueOccurrences
+
+	for _, valueIndex := range valueIndexes {
+		occurrenceIndex := slices.IndexFunc(occurrences, func(occurrence valueOccurrences) bool {
+			return values[occurrence.first].value == values[valueIndex].value
+		})
+
+		switch {
+		case occurrenceIndex < 0:
+			occurrences = append(occurrences, valueOccurrences{first: valueIndex, firstInOtherGenre: -1})
+		case occurrences[occurrenceIndex].firstInOtherGenre < 0 &&
+			genreNameOf(valueIndex) != genreNameOf(occurrences[occurrenceIndex].first):
+			occurrences[occurrenceIndex].firstInOtherGenre = valueIndex
+		}
+	}
+
+	return occurrences
+}
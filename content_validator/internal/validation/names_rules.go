@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"slices"
+	"strings"
+)
+
+// caseExceptionsOption lists the values the case rules accept even though they are not in lowercase (e.g. acronyms).
+const caseExceptionsOption = "exceptions"
+
+func init() {
+	Register(Rule{
+		ID:              "name-not-empty",
+		Description:     "Genre names must not be empty",
+		Hint:            "give the genre a name or remove the genre",
+		Category:        CategoryEmptiness,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateNameNotEmpty),
+	})
+
+	Register(Rule{
+		ID:              "alt-names-not-empty",
+		Description:     "Alternative names must not be empty",
+		Hint:            "remove the empty string from altNames",
+		Category:        CategoryEmptiness,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateAltNamesNotEmpty),
+	})
+
+	Register(Rule{
+		ID:              "name-trimmed",
+		Description:     "Genre names must not have leading or trailing whitespace",
+		Hint:            "remove the spaces around the name",
+		Category:        CategoryWhitespace,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateNameTrimmed),
+		Fix:             trimValue,
+	})
+
+	Register(Rule{
+		ID:              "alt-names-trimmed",
+		Description:     "Alternative names must not have leading or trailing whitespace",
+		Hint:            "remove the spaces around the alternative name",
+		Category:        CategoryWhitespace,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateAltNamesTrimmed),
+		Fix:             trimValue,
+	})
+
+	Register(Rule{
+		ID:              "name-single-spaced",
+		Description:     "Words of genre names must be separated by single spaces",
+		Hint:            "replace the whitespace between the words with a single space",
+		Category:        CategoryWhitespace,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateNameSingleSpaced),
+		Fix:             collapseWhitespace,
+	})
+
+	Register(Rule{
+		ID:              "alt-names-single-spaced",
+		Description:     "Words of alternative names must be separated by single spaces",
+		Hint:            "replace the whitespace between the words with a single space",
+		Category:        CategoryWhitespace,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateAltNamesSingleSpaced),
+		Fix:             collapseWhitespace,
+	})
+
+	Register(Rule{
+		ID:              "name-case",
+		Description:     "Genre names must be in lowercase",
+		Hint:            "lowercase the name, or list it in the \"exceptions\" option if it is an acronym",
+		Category:        CategoryCase,
+		DefaultSeverity: SeverityError,
+		Options:         Options{caseExceptionsOption: []string{}},
+		Check:           checkNameCase,
+		Fix:             lowercaseValue,
+	})
+
+	Register(Rule{
+		ID:              "alt-names-case",
+		Description:     "Alternative names must be in lowercase",
+		Hint:            "lowercase the alternative name, or list it in the \"exceptions\" option if it is an acronym",
+		Category:        CategoryCase,
+		DefaultSeverity: SeverityError,
+		Options:         Options{caseExceptionsOption: []string{}},
+		Check:           checkAltNamesCase,
+		Fix:             lowercaseValue,
+	})
+
+	Register(Rule{
+		ID:              "name-unique",
+		Description:     "Genre names must be unique",
+		Hint:            "merge the genres or rename one of them",
+		Category:        CategoryUniqueness,
+		DefaultSeverity: SeverityError,
+		Check:           withDataset(validateNameUnique),
+	})
+
+	Register(Rule{
+		ID:              "alt-names-unique",
+		Description:     "Alternative names must be unique within a genre",
+		Hint:            "remove the repeated alternative name",
+		Category:        CategoryUniqueness,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateAltNamesUnique),
+		Fix:             removeValue,
+	})
+
+	Register(Rule{
+		ID:              "name-alt-name-collision",
+		Description:     "Genre names must not be used as alternative names",
+		Hint:            "remove the alternative name or merge it with the genre it names",
+		Category:        CategoryCollision,
+		DefaultSeverity: SeverityError,
+		Check:           withDataset(validateGenreNameNoCollisionsWithAltNames),
+	})
+
+	Register(Rule{
+		ID:              "alt-name-collision",
+		Description:     "Alternative names must not be shared between genres",
+		Hint:            "keep the alternative name in only one of the genres",
+		Category:        CategoryCollision,
+		DefaultSeverity: SeverityError,
+		Check:           withDataset(validateCollidingAltNames),
+	})
+}
+
+func checkNameCase(dataset *Dataset, options Options) []Finding {
+	return withoutExceptions(ValidateNameCase(dataset.Genres), options.Strings(caseExceptionsOption))
+}
+
+func checkAltNamesCase(dataset *Dataset, options Options) []Finding {
+	return withoutExceptions(ValidateAltNamesCase(dataset.Genres), options.Strings(caseExceptionsOption))
+}
+
+func withoutExceptions(findings []Finding, exceptions []string) []Finding {
+	return slices.DeleteFunc(findings, func(finding Finding) bool {
+		return slices.Contains(exceptions, finding.Value)
+	})
+}
+
+func trimValue(value string) (string, bool) {
+	return strings.TrimSpace(value), true
+}
+
+func collapseWhitespace(value string) (string, bool) {
+	return strings.Join(strings.Fields(value), " "), true
+}
+
+func lowercaseValue(value string) (string, bool) {
+	return strings.ToLower(value), true
+}
+
+func removeValue(string) (string, bool) {
+	return "", false
+}
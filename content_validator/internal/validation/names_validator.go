@@ -2,8 +2,10 @@ package validation
 
 import (
 	"content_validator/internal/data"
+	"fmt"
 	"slices"
 	"strings"
+	"unicode"
 )
 
 // ValidateNameNotEmpty checks if all game genres in the provided slice have non-empty names.
@@ -14,7 +16,7 @@ import (
 //
 // Returns:
 //
-//	bool: true if all genres have non-empty names, false otherwise
+//	[]Finding: A finding for every genre with an empty name, or nil if none found
 //
 // Examples:
 //
@@ -23,26 +25,29 @@ import (
 //	    {Name: "Adventure"},
 //	}
 //
-//	ValidateNameNotEmpty(validGenres)  // returns true
+//	ValidateNameNotEmpty(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
 //	    {Name: "Action"},
 //	    {Name: ""},
 //	}
 //
-//	ValidateNameNotEmpty(invalidGenres)  // returns false
+//	ValidateNameNotEmpty(invalidGenres)  // returns a finding for the genre at index 1
 //
 // Note:
 //
-//	If the input slice is empty, the function returns true.
-func ValidateNameNotEmpty(genres []data.GameGenre) bool {
-	for _, genre := range genres {
+//	Names consisting only of whitespace are considered empty.
+//	If the input slice is empty, the function returns nil.
+func ValidateNameNotEmpty(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
 		if strings.TrimSpace(genre.Name) == "" {
-			return false
+			findings = append(findings, newNameFinding(genreIndex, genre.Name, "genre name is empty"))
 		}
 	}
 
-	return true
+	return findings
 }
 
 // ValidateAltNamesNotEmpty checks if all alternative names for all game genres are non-empty.
@@ -53,8 +58,7 @@ func ValidateNameNotEmpty(genres []data.GameGenre) bool {
 //
 // Returns:
 //
-//	bool: true if all alternative names are non-empty, false otherwise
-//	[]string: A slice containing the names of genres with empty alternative names, or nil if none found
+//	[]Finding: A finding for every empty alternative name, or nil if none found
 //
 // Examples:
 //
@@ -63,44 +67,33 @@ func ValidateNameNotEmpty(genres []data.GameGenre) bool {
 //	    {Name: "Adventure", AltNames: []string{"Adv"}},
 //	}
 //
-//	valid, _ := ValidateAltNamesNotEmpty(validGenres)  // returns true, nil
+//	ValidateAltNamesNotEmpty(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
 //	    {Name: "Action", AltNames: []string{"Act", ""}},
-//	    {Name: "Adventure", AltNames: []string{"Adv"}},
-//	}
-//
-//	valid, invalid := ValidateAltNamesNotEmpty(invalidGenres)  // returns false, []string{"Action"}
-//
-//	multipleInvalidGenres := []data.GameGenre{
-//	    {Name: "Action", AltNames: []string{"Act", ""}},
 //	    {Name: "Adventure", AltNames: []string{"Adv", "  "}},
 //	}
 //
-//	valid, invalid := ValidateAltNamesNotEmpty(multipleInvalidGenres)  // returns false, []string{"Action", "Adventure"}
+//	ValidateAltNamesNotEmpty(invalidGenres)
+//	// returns findings for altNames[1] of "Action" and altNames[1] of "Adventure"
 //
 // Note:
 //
-//	A genre name appears only once in the returned slice even if it has multiple empty alternative names.
+//	Alternative names consisting only of whitespace are considered empty.
 //	Genres with no alternative names (empty slice) are considered valid.
-func ValidateAltNamesNotEmpty(genres []data.GameGenre) (bool, []string) {
-	var invalidGenreNames []string
+func ValidateAltNamesNotEmpty(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
-		for _, altName := range genre.AltNames {
+	for genreIndex, genre := range genres {
+		for altNameIndex, altName := range genre.AltNames {
 			if strings.TrimSpace(altName) == "" {
-				if !slices.Contains(invalidGenreNames, genre.Name) {
-					invalidGenreNames = append(invalidGenreNames, genre.Name)
-				}
+				findings = append(findings,
+					newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName, "alternative name is empty"))
 			}
 		}
 	}
 
-	if len(invalidGenreNames) == 0 {
-		return true, nil
-	}
-
-	return false, invalidGenreNames
+	return findings
 }
 
 // ValidateNameTrimmed checks if all game genre names are properly trimmed of leading and trailing whitespace.
@@ -111,8 +104,7 @@ func ValidateAltNamesNotEmpty(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all genre names are properly trimmed, false otherwise
-//	[]string: A slice containing the untrimmed genre names, or nil if none found
+//	[]Finding: A finding for every untrimmed genre name, or nil if none found
 //
 // Examples:
 //
@@ -121,39 +113,29 @@ func ValidateAltNamesNotEmpty(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "Adventure"},
 //	}
 //
-//	valid, _ := ValidateNameTrimmed(validGenres)  // returns true, nil
+//	ValidateNameTrimmed(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
-//	    {Name: "Action"},
-//	    {Name: "Adventure "},
-//	}
-//
-//	valid, invalid := ValidateNameTrimmed(invalidGenres)  // returns false, []string{"Adventure "}
-//
-//	multipleInvalidGenres := []data.GameGenre{
 //	    {Name: " Action"},
 //	    {Name: "Adventure "},
 //	}
 //
-//	valid, invalid := ValidateNameTrimmed(multipleInvalidGenres)  // returns false, []string{" Action", "Adventure "}
+//	ValidateNameTrimmed(invalidGenres)  // returns findings for " Action" and "Adventure "
 //
 // Note:
 //
 //	The function only checks for leading and trailing whitespace, not whitespace within the name.
-func ValidateNameTrimmed(genres []data.GameGenre) (bool, []string) {
-	var invalidNames []string
+func ValidateNameTrimmed(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
+	for genreIndex, genre := range genres {
 		if genre.Name != strings.TrimSpace(genre.Name) {
-			invalidNames = append(invalidNames, genre.Name)
+			findings = append(findings,
+				newNameFinding(genreIndex, genre.Name, "genre name has leading or trailing whitespace"))
 		}
 	}
 
-	if len(invalidNames) == 0 {
-		return true, nil
-	}
-
-	return false, invalidNames
+	return findings
 }
 
 // ValidateAltNamesTrimmed checks if all alternative names for all game genres are properly trimmed
@@ -165,8 +147,7 @@ func ValidateNameTrimmed(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all alternative names are properly trimmed, false otherwise
-//	[]string: A slice containing the names of genres with untrimmed alternative names, or nil if none found
+//	[]Finding: A finding for every untrimmed alternative name, or nil if none found
 //
 // Examples:
 //
@@ -175,48 +156,80 @@ func ValidateNameTrimmed(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "Adventure", AltNames: []string{"Adv"}},
 //	}
 //
-//	valid, _ := ValidateAltNamesTrimmed(validGenres)  // returns true, nil
+//	ValidateAltNamesTrimmed(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
-//	    {Name: "Action", AltNames: []string{"Act", "Fighting "}},
-//	    {Name: "Adventure", AltNames: []string{"Adv"}},
-//	}
-//
-//	valid, invalid := ValidateAltNamesTrimmed(invalidGenres)  // returns false, []string{"Action"}
-//
-//	multipleInvalidGenres := []data.GameGenre{
 //	    {Name: "Action", AltNames: []string{"Act", " Fighting"}},
 //	    {Name: "Adventure", AltNames: []string{"Adv", "  RPG "}},
 //	}
 //
-//	valid, invalid := ValidateAltNamesTrimmed(multipleInvalidGenres)  // returns false, []string{"Action", "Adventure"}
+//	ValidateAltNamesTrimmed(invalidGenres)
+//	// returns findings for altNames[1] of "Action" and altNames[1] of "Adventure"
 //
 // Note:
 //
-//	A genre name appears only once in the returned slice even if it has multiple untrimmed alternative names.
-//	The function returns the genre names (not the alternative names) that contain untrimmed alternative names.
 //	Genres with no alternative names (empty slice) are considered valid.
-func ValidateAltNamesTrimmed(genres []data.GameGenre) (bool, []string) {
-	var invalidGenreNames []string
+func ValidateAltNamesTrimmed(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
-		for _, altName := range genre.AltNames {
+	for genreIndex, genre := range genres {
+		for altNameIndex, altName := range genre.AltNames {
 			if altName != strings.TrimSpace(altName) {
-				if !slices.Contains(invalidGenreNames, genre.Name) {
-					invalidGenreNames = append(invalidGenreNames, genre.Name)
-				}
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					"alternative name has leading or trailing whitespace"))
 			}
 		}
 	}
 
-	if len(invalidGenreNames) == 0 {
-		return true, nil
+	return findings
+}
+
+// ValidateNameSingleSpaced checks if the words of all game genre names are separated by single spaces.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every genre name with irregular whitespace inside it, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "beat 'em up"},
+//	    {Name: "run-n-gun"},
+//	}
+//
+//	ValidateNameSingleSpaced(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "beat  'em up"},
+//	    {Name: "run-n-gun\tshooter"},
+//	}
+//
+//	ValidateNameSingleSpaced(invalidGenres)  // returns findings for "beat  'em up" and "run-n-gun\tshooter"
+//
+// Note:
+//
+//	Consecutive whitespace characters, tabs and line breaks are reported.
+//	Leading and trailing whitespace is ignored, it is reported by ValidateNameTrimmed.
+//	A single non-ASCII space, like a non-breaking space, is reported by the confusable characters rule instead.
+func ValidateNameSingleSpaced(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
+		if hasIrregularInnerWhitespace(genre.Name) {
+			findings = append(findings, newNameFinding(genreIndex, genre.Name,
+				"genre name has consecutive whitespace, a tab or a line break inside it"))
+		}
 	}
 
-	return false, invalidGenreNames
+	return findings
 }
 
-// ValidateNameCase checks if all game genre names are in lowercase.
+// ValidateAltNamesSingleSpaced checks if the words of all alternative names for all game genres are separated by
+// single spaces.
 //
 // Parameters:
 //
@@ -224,49 +237,97 @@ func ValidateAltNamesTrimmed(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all genre names are in lowercase, false otherwise
-//	[]string: A slice containing the genre names that are not in lowercase, or nil if none found
+//	[]Finding: A finding for every alternative name with irregular whitespace inside it, or nil if none found
 //
 // Examples:
 //
 //	validGenres := []data.GameGenre{
-//	    {Name: "action"},
-//	    {Name: "adventure"},
+//	    {Name: "beat 'em up", AltNames: []string{"brawler", "beat-'em-up"}},
 //	}
 //
-//	valid, _ := ValidateNameCase(validGenres)  // returns true, nil
+//	ValidateAltNamesSingleSpaced(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
+//	    {Name: "beat 'em up", AltNames: []string{"brawler", "beat 'em  up"}},
+//	}
+//
+//	ValidateAltNamesSingleSpaced(invalidGenres)  // returns a finding for altNames[1] of "beat 'em up"
+//
+// Note:
+//
+//	The same whitespace as in ValidateNameSingleSpaced is reported.
+func ValidateAltNamesSingleSpaced(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
+		for altNameIndex, altName := range genre.AltNames {
+			if hasIrregularInnerWhitespace(altName) {
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					"alternative name has consecutive whitespace, a tab or a line break inside it"))
+			}
+		}
+	}
+
+	return findings
+}
+
+// hasIrregularInnerWhitespace checks if the value, without its leading and trailing whitespace, has consecutive
+// whitespace characters or ASCII whitespace other than a space (tabs and line breaks).
+func hasIrregularInnerWhitespace(value string) bool {
+	previousIsSpace := false
+
+	for _, character := range strings.TrimSpace(value) {
+		isSpace := unicode.IsSpace(character)
+
+		if isSpace && (previousIsSpace || (character != ' ' && character <= unicode.MaxASCII)) {
+			return true
+		}
+
+		previousIsSpace = isSpace
+	}
+
+	return false
+}
+
+// ValidateNameCase checks if all game genre names are in lowercase.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every genre name that is not in lowercase, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
 //	    {Name: "action"},
-//	    {Name: "Adventure"},
+//	    {Name: "adventure"},
 //	}
 //
-//	valid, invalid := ValidateNameCase(invalidGenres)  // returns false, []string{"Adventure"}
+//	ValidateNameCase(validGenres)  // returns nil
 //
-//	multipleInvalidGenres := []data.GameGenre{
-//	    {Name: "Action"},
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "action"},
 //	    {Name: "Adventure"},
 //	}
 //
-//	valid, invalid := ValidateNameCase(multipleInvalidGenres)  // returns false, []string{"Action", "Adventure"}
+//	ValidateNameCase(invalidGenres)  // returns a finding for "Adventure"
 //
 // Note:
 //
 //	Names with numbers, symbols, or spaces are considered valid as long as any letters are lowercase.
-func ValidateNameCase(genres []data.GameGenre) (bool, []string) {
-	var invalidNames []string
+func ValidateNameCase(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
+	for genreIndex, genre := range genres {
 		if genre.Name != strings.ToLower(genre.Name) {
-			invalidNames = append(invalidNames, genre.Name)
+			findings = append(findings, newNameFinding(genreIndex, genre.Name, "genre name is not in lowercase"))
 		}
 	}
 
-	if len(invalidNames) == 0 {
-		return true, nil
-	}
-
-	return false, invalidNames
+	return findings
 }
 
 // ValidateAltNamesCase checks if all alternative names for all game genres are in lowercase.
@@ -277,8 +338,7 @@ func ValidateNameCase(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all alternative names are in lowercase, false otherwise
-//	[]string: A slice containing the alternative names that are not in lowercase, or nil if none found
+//	[]Finding: A finding for every alternative name that is not in lowercase, or nil if none found
 //
 // Examples:
 //
@@ -287,42 +347,32 @@ func ValidateNameCase(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "adventure", AltNames: []string{"adv"}},
 //	}
 //
-//	valid, _ := ValidateAltNamesCase(validGenres)  // returns true, nil
+//	ValidateAltNamesCase(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
-//	    {Name: "action", AltNames: []string{"act", "Fighting"}},
-//	    {Name: "adventure", AltNames: []string{"adv"}},
-//	}
-//
-//	valid, invalid := ValidateAltNamesCase(invalidGenres)  // returns false, []string{"Fighting"}
-//
-//	multipleInvalidGenres := []data.GameGenre{
 //	    {Name: "action", AltNames: []string{"Act", "fighting"}},
 //	    {Name: "adventure", AltNames: []string{"Adv", "RPG"}},
 //	}
 //
-//	valid, invalid := ValidateAltNamesCase(multipleInvalidGenres)  // returns false, []string{"Act", "Adv", "RPG"}
+//	ValidateAltNamesCase(invalidGenres)  // returns findings for "Act", "Adv" and "RPG"
 //
 // Note:
 //
 //	Alternative names with numbers, symbols, or spaces are considered valid as long as any letters are lowercase.
 //	Genres with no alternative names (empty slice) are considered valid.
-func ValidateAltNamesCase(genres []data.GameGenre) (bool, []string) {
-	var invalidAltNames []string
+func ValidateAltNamesCase(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
-		for _, altName := range genre.AltNames {
+	for genreIndex, genre := range genres {
+		for altNameIndex, altName := range genre.AltNames {
 			if altName != strings.ToLower(altName) {
-				invalidAltNames = append(invalidAltNames, altName)
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					"alternative name is not in lowercase"))
 			}
 		}
 	}
 
-	if len(invalidAltNames) == 0 {
-		return true, nil
-	}
-
-	return false, invalidAltNames
+	return findings
 }
 
 // ValidateNameUnique checks if there are any duplicate genre names in the provided slice.
@@ -333,8 +383,7 @@ func ValidateAltNamesCase(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all genre names are unique, false if duplicates exist
-//	[]string: A slice containing the duplicate genre names, or nil if none found
+//	[]Finding: A finding for every repeated occurrence of a genre name, or nil if none found
 //
 // Examples:
 //
@@ -344,7 +393,7 @@ func ValidateAltNamesCase(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "rpg"},
 //	}
 //
-//	valid, _ := ValidateNameUnique(uniqueGenres)  // returns true, nil
+//	ValidateNameUnique(uniqueGenres)  // returns nil
 //
 //	duplicateGenres := []data.GameGenre{
 //	    {Name: "action"},
@@ -352,41 +401,31 @@ func ValidateAltNamesCase(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "action"},  // duplicate name
 //	}
 //
-//	valid, dupes := ValidateNameUnique(duplicateGenres)  // returns false, []string{"action"}
-//
-//	multipleGenres := []data.GameGenre{
-//	    {Name: "action"},
-//	    {Name: "action"},  // duplicate
-//	    {Name: "rpg"},
-//	    {Name: "rpg"},     // duplicate
-//	}
-//
-//	valid, dupes := ValidateNameUnique(multipleGenres)  // returns false, []string{"action", "rpg"}
+//	ValidateNameUnique(duplicateGenres)  // returns a finding for the genre at index 2
 //
 // Note:
 //
 //	The function only checks for exact string matches and is case-sensitive.
-//	The order of duplicate names in the returned slice is not guaranteed.
-func ValidateNameUnique(genres []data.GameGenre) (bool, []string) {
-	nameCounts := make(map[string]int)
+//	The first occurrence of a name is considered the original, so it is not reported.
+func ValidateNameUnique(genres []data.GameGenre) []Finding {
+	return validateNameUnique(NewDataset(genres))
+}
 
-	for _, category := range genres {
-		nameCounts[category.Name]++
-	}
+func validateNameUnique(dataset *Dataset) []Finding {
+	var findings []Finding
 
-	var duplicates []string
+	for genreIndex, genre := range dataset.Genres {
+		firstIndex := dataset.Index().GenreIndexes(genre.Name)[0]
 
-	for name, count := range nameCounts {
-		if count > 1 {
-			duplicates = append(duplicates, name)
+		if firstIndex == genreIndex {
+			continue
 		}
-	}
 
-	if len(duplicates) > 0 {
-		return false, duplicates
+		findings = append(findings, newNameFinding(genreIndex, genre.Name,
+			fmt.Sprintf("genre name is already used by genre #%d", firstIndex)))
 	}
 
-	return true, nil
+	return findings
 }
 
 // ValidateAltNamesUnique checks if all alternative names within each game genre are unique.
@@ -397,8 +436,7 @@ func ValidateNameUnique(genres []data.GameGenre) (bool, []string) {
 //
 // Returns:
 //
-//	bool: true if all genres have unique alternative names, false otherwise
-//	[]string: A slice containing the names of genres with duplicate alternative names, or nil if none found
+//	[]Finding: A finding for every repeated occurrence of an alternative name within a genre, or nil if none found
 //
 // Examples:
 //
@@ -407,63 +445,44 @@ func ValidateNameUnique(genres []data.GameGenre) (bool, []string) {
 //	    {Name: "adventure", AltNames: []string{"adv", "quest"}},
 //	}
 //
-//	valid, _ := ValidateAltNamesUnique(validGenres)  // returns true, nil
+//	ValidateAltNamesUnique(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
 //	    {Name: "action", AltNames: []string{"act", "fighting", "act"}},  // duplicate "act"
 //	    {Name: "adventure", AltNames: []string{"adv", "quest"}},
 //	}
 //
-//	valid, invalid := ValidateAltNamesUnique(invalidGenres)  // returns false, []string{"action"}
-//
-//	multipleInvalidGenres := []data.GameGenre{
-//	    {Name: "action", AltNames: []string{"act", "act"}},  // duplicate
-//	    {Name: "adventure", AltNames: []string{"adv", "quest", "adv"}},  // duplicate
-//	}
-//
-//	valid, invalid := ValidateAltNamesUnique(multipleInvalidGenres)  // returns false, []string{"action", "adventure"}
+//	ValidateAltNamesUnique(invalidGenres)  // returns a finding for altNames[2] of "action"
 //
 // Note:
 //
-//	The function returns the names of genres that contain duplicate alternative names, not the duplicate names
-//	themselves.
 //	The function checks for duplicates within each genre's alternative names, not across different genres.
+//	The first occurrence of an alternative name is considered the original, so it is not reported.
 //	Genres with no alternative names or only one alternative name are always considered valid.
-func ValidateAltNamesUnique(genres []data.GameGenre) (bool, []string) {
-	var invalidGenres []string
+func ValidateAltNamesUnique(genres []data.GameGenre) []Finding {
+	var findings []Finding
 
-	for _, genre := range genres {
-		seen := make(map[string]bool)
-		hasDuplicates := false
+	for genreIndex, genre := range genres {
+		firstIndexes := make(map[string]int)
 
-		for _, altName := range genre.AltNames {
-			if seen[altName] {
-				hasDuplicates = true
+		for altNameIndex, altName := range genre.AltNames {
+			firstIndex, seen := firstIndexes[altName]
 
-				break
-			}
+			if !seen {
+				firstIndexes[altName] = altNameIndex
 
-			seen[altName] = true
-		}
+				continue
+			}
 
-		if hasDuplicates {
-			invalidGenres = append(invalidGenres, genre.Name)
+			findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+				fmt.Sprintf("alternative name repeats %s", AltNameField(firstIndex))))
 		}
 	}
 
-	if len(invalidGenres) == 0 {
-		return true, nil
-	}
-
-	return false, invalidGenres
-}
-
-type GenreWithCollidingAltName struct {
-	CollidingGenreName        string
-	GenreWithCollidingAltName string
+	return findings
 }
 
-// ValidateGenreNameNoCollisionsWithAltNames checks if any genre name appears as an alternative name in any other genre.
+// ValidateGenreNameNoCollisionsWithAltNames checks if any genre name appears as an alternative name in any genre.
 //
 // Parameters:
 //
@@ -471,8 +490,7 @@ type GenreWithCollidingAltName struct {
 //
 // Returns:
 //
-//	bool: true if no collisions exist, false otherwise
-//	[]GenreWithCollidingAltName: A slice containing details about each collision, or nil if none found
+//	[]Finding: A finding for every alternative name that is also a genre name, or nil if none found
 //
 // Examples:
 //
@@ -481,54 +499,47 @@ type GenreWithCollidingAltName struct {
 //	    {Name: "adventure", AltNames: []string{"adv", "quest"}},
 //	}
 //
-//	valid, _ := ValidateGenreNameNoCollisionsWithAltNames(validGenres)  // returns true, nil
+//	ValidateGenreNameNoCollisionsWithAltNames(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
 //	    {Name: "action", AltNames: []string{"act", "fighting"}},
 //	    {Name: "adventure", AltNames: []string{"adv", "action"}},  // "action" appears as alt name
 //	}
 //
-//	valid, collisions := ValidateGenreNameNoCollisionsWithAltNames(invalidGenres)
-//	// returns false, [{CollidingGenreName: "action", GenreWithCollidingAltName: "adventure"}]
-//
-//	multipleCollisions := []data.GameGenre{
-//	    {Name: "action", AltNames: []string{"rpg", "fighting"}},
-//	    {Name: "adventure", AltNames: []string{"action", "quest"}},
-//	    {Name: "rpg", AltNames: []string{"role-playing", "adventure"}},
-//	}
-//
-//	valid, collisions := ValidateGenreNameNoCollisionsWithAltNames(multipleCollisions)
-//	// returns false with multiple collision entries
+//	ValidateGenreNameNoCollisionsWithAltNames(invalidGenres)
+//	// returns a finding for altNames[1] of "adventure"
 //
 // Note:
 //
 //	The function checks for exact string matches and is case-sensitive.
-//	A genre name can appear in multiple collisions if it appears as an alternative name in multiple genres.
-func ValidateGenreNameNoCollisionsWithAltNames(genres []data.GameGenre) (bool, []GenreWithCollidingAltName) {
-	var collisions []GenreWithCollidingAltName
-
-	for _, genre := range genres {
-		for _, otherGenre := range genres {
-			if slices.Contains(otherGenre.AltNames, genre.Name) {
-				collisions = append(collisions, GenreWithCollidingAltName{
-					CollidingGenreName:        genre.Name,
-					GenreWithCollidingAltName: otherGenre.Name,
-				})
+//	A genre whose alternative name repeats its own name is reported as well.
+//	Repeated occurrences of an alternative name within a genre are reported only once, and so are alternative names
+//	that are the name of several genres.
+//	The findings are reported on the alternative names, the colliding genre is mentioned in the message.
+//	The findings are in file order.
+func ValidateGenreNameNoCollisionsWithAltNames(genres []data.GameGenre) []Finding {
+	return validateGenreNameNoCollisionsWithAltNames(NewDataset(genres))
+}
+
+func validateGenreNameNoCollisionsWithAltNames(dataset *Dataset) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range dataset.Genres {
+		for altNameIndex, altName := range genre.AltNames {
+			if slices.Index(genre.AltNames, altName) != altNameIndex {
+				continue
 			}
-		}
-	}
 
-	if len(collisions) == 0 {
-		return true, nil
+			// NOTE: genres with the same name are one collision, the duplicate names are reported by
+			// ValidateNameUnique.
+			if len(dataset.Index().GenreIndexes(altName)) > 0 {
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					fmt.Sprintf("alternative name is also the name of genre %q", altName)))
+			}
+		}
 	}
 
-	return false, collisions
-}
-
-type AltNameCollision struct {
-	AltName                   string
-	CollidingGenreName        string
-	GenreWithCollidingAltName string
+	return findings
 }
 
 // ValidateCollidingAltNames checks if any genres have the same alternative names.
@@ -539,8 +550,7 @@ type AltNameCollision struct {
 //
 // Returns:
 //
-//	bool: true if no collisions exist, false otherwise
-//	[]AltNameCollision: A slice containing details about each collision, or nil if none found
+//	[]Finding: A finding for every alternative name shared with another genre, or nil if none found
 //
 // Examples:
 //
@@ -549,53 +559,58 @@ type AltNameCollision struct {
 //	    {Name: "adventure", AltNames: []string{"adv", "quest"}},
 //	}
 //
-//	valid, _ := ValidateCollidingAltNames(validGenres)  // returns true, nil
+//	ValidateCollidingAltNames(validGenres)  // returns nil
 //
 //	invalidGenres := []data.GameGenre{
 //	    {Name: "action", AltNames: []string{"act", "fighting"}},
 //	    {Name: "adventure", AltNames: []string{"adv", "fighting"}},  // "fighting" appears in both
 //	}
 //
-//	valid, collisions := ValidateCollidingAltNames(invalidGenres)
-//	// returns false, [{AltName: "fighting", CollidingGenreName: "action", GenreWithCollidingAltName: "adventure"}]
-//
-//	multipleCollisions := []data.GameGenre{
-//	    {Name: "action", AltNames: []string{"act", "game"}},
-//	    {Name: "adventure", AltNames: []string{"act", "quest"}},
-//	    {Name: "rpg", AltNames: []string{"game", "role-playing"}},
-//	}
-//
-//	valid, collisions := ValidateCollidingAltNames(multipleCollisions)
-//	// returns false with multiple collision entries
+//	ValidateCollidingAltNames(invalidGenres)
+//	// returns findings for altNames[1] of "action" and altNames[1] of "adventure"
 //
 // Note:
 //
 //	The function checks for exact string matches and is case-sensitive.
 //	The function only reports collisions between different genres (not within the same genre).
-func ValidateCollidingAltNames(genres []data.GameGenre) (bool, []AltNameCollision) {
-	var collisions []AltNameCollision
+//	Every side of a collision is reported, so a pair of colliding genres produces two findings.
+//	The findings are in file order.
+func ValidateCollidingAltNames(genres []data.GameGenre) []Finding {
+	return validateCollidingAltNames(NewDataset(genres))
+}
+
+func validateCollidingAltNames(dataset *Dataset) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range dataset.Genres {
+		for altNameIndex, altName := range genre.AltNames {
+			for _, altNameRef := range firstRefPerGenre(dataset.Index().AltNameRefs(altName)) {
+				otherGenre := dataset.Genres[altNameRef.GenreIndex]
 
-	for _, genre := range genres {
-		for _, altName := range genre.AltNames {
-			for _, otherGenre := range genres {
 				if genre.Name == otherGenre.Name {
 					continue
 				}
 
-				if slices.Contains(otherGenre.AltNames, altName) {
-					collisions = append(collisions, AltNameCollision{
-						AltName:                   altName,
-						CollidingGenreName:        genre.Name,
-						GenreWithCollidingAltName: otherGenre.Name,
-					})
-				}
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					fmt.Sprintf("alternative name is also an alternative name of genre %q", otherGenre.Name)))
 			}
 		}
 	}
 
-	if len(collisions) == 0 {
-		return true, nil
+	return findings
+}
+
+// firstRefPerGenre keeps only the first reference to each genre. The references must be in file order.
+func firstRefPerGenre(altNameRefs []AltNameRef) []AltNameRef {
+	var firstRefs []AltNameRef
+
+	for _, altNameRef := range altNameRefs {
+		if len(firstRefs) > 0 && firstRefs[len(firstRefs)-1].GenreIndex == altNameRef.GenreIndex {
+			continue
+		}
+
+		firstRefs = append(firstRefs, altNameRef)
 	}
 
-	return false, collisions
+	return firstRefs
 }
@@ -3,7 +3,6 @@ package validation
 import (
 	"content_validator/internal/data"
 	"reflect"
-	"sort"
 	"testing"
 )
 
@@ -64,7 +63,7 @@ func TestValidateNameNotEmpty(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			valid := ValidateNameNotEmpty(test.input)
+			valid := len(ValidateNameNotEmpty(test.input)) == 0
 
 			if valid != test.expectedValid {
 				runner.Errorf("Validity mismatch: got %v, want %v", valid, test.expectedValid)
@@ -77,56 +76,59 @@ func TestValidateAltNamesNotEmpty(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantInvalid []string
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:        "empty genres slice",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantInvalid: nil,
+			name:         "empty genres slice",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "genre with empty AltNames slice",
 			genres: []data.GameGenre{
 				{Name: "RPG", AltNames: []string{}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "single invalid alt name (empty string)",
 			genres: []data.GameGenre{
 				{Name: "Action", AltNames: []string{""}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Action"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: ""},
+			},
 		},
 		{
 			name: "single invalid alt name (whitespace)",
 			genres: []data.GameGenre{
 				{Name: "Adventure", AltNames: []string{"   "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Adventure"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "   "},
+			},
 		},
 		{
 			name: "multiple invalid alt names in one genre",
 			genres: []data.GameGenre{
 				{Name: "Strategy", AltNames: []string{"", "  ", "   "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Strategy"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: ""},
+				{genreIndex: 0, field: "altNames[1]", value: "  "},
+				{genreIndex: 0, field: "altNames[2]", value: "   "},
+			},
 		},
 		{
 			name: "mixed valid and invalid alt names",
 			genres: []data.GameGenre{
 				{Name: "Puzzle", AltNames: []string{"BrainTeaser", ""}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Puzzle"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: ""},
+			},
 		},
 		{
 			name: "multiple genres with invalid entries",
@@ -134,24 +136,26 @@ func TestValidateAltNamesNotEmpty(testRunner *testing.T) {
 				{Name: "RPG", AltNames: []string{"", "RolePlaying"}},
 				{Name: "FPS", AltNames: []string{"Shooter", "   "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"RPG", "FPS"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: ""},
+				{genreIndex: 1, field: "altNames[1]", value: "   "},
+			},
 		},
 		{
 			name: "genre with empty name and invalid alt",
 			genres: []data.GameGenre{
 				{Name: "", AltNames: []string{""}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{""},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: ""},
+			},
 		},
 		{
 			name: "all valid alt names",
 			genres: []data.GameGenre{
 				{Name: "Racing", AltNames: []string{"Driving", "Cars"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 	}
 
@@ -159,14 +163,10 @@ func TestValidateAltNamesNotEmpty(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotInvalid := ValidateAltNamesNotEmpty(test.genres)
+			gotFindings := locationsOf(ValidateAltNamesNotEmpty(test.genres))
 
-			if gotValid != test.wantValid {
-				runner.Errorf("got valid %v, want %v", gotValid, test.wantValid)
-			}
-
-			if !reflect.DeepEqual(gotInvalid, test.wantInvalid) {
-				runner.Errorf("got invalid %v, want %v", gotInvalid, test.wantInvalid)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -176,16 +176,14 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantInvalid []string
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:        "empty slice",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantInvalid: nil,
+			name:         "empty slice",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "valid names",
@@ -194,8 +192,7 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: "Action-Adventure", AltNames: nil},
 				{Name: "Real-Time Strategy", AltNames: nil},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "leading whitespace",
@@ -203,8 +200,10 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: " RPG", AltNames: nil},
 				{Name: "  Simulation", AltNames: nil},
 			},
-			wantValid:   false,
-			wantInvalid: []string{" RPG", "  Simulation"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: " RPG"},
+				{genreIndex: 1, field: "name", value: "  Simulation"},
+			},
 		},
 		{
 			name: "trailing whitespace",
@@ -212,8 +211,10 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: "FPS ", AltNames: nil},
 				{Name: "Racing  ", AltNames: nil},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"FPS ", "Racing  "},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "FPS "},
+				{genreIndex: 1, field: "name", value: "Racing  "},
+			},
 		},
 		{
 			name: "mixed whitespace types",
@@ -222,8 +223,11 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: "Newline\n", AltNames: nil},
 				{Name: " \t\nAll Three\n\t ", AltNames: nil},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"\tTabbed", "Newline\n", " \t\nAll Three\n\t "},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "\tTabbed"},
+				{genreIndex: 1, field: "name", value: "Newline\n"},
+				{genreIndex: 2, field: "name", value: " \t\nAll Three\n\t "},
+			},
 		},
 		{
 			name: "whitespace-only names",
@@ -232,8 +236,11 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: "\t", AltNames: nil},
 				{Name: "\n", AltNames: nil},
 			},
-			wantValid:   false,
-			wantInvalid: []string{" ", "\t", "\n"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: " "},
+				{genreIndex: 1, field: "name", value: "\t"},
+				{genreIndex: 2, field: "name", value: "\n"},
+			},
 		},
 		{
 			name: "mixed valid and invalid",
@@ -243,16 +250,17 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 				{Name: "AlsoValid", AltNames: nil},
 				{Name: "Invalid ", AltNames: nil},
 			},
-			wantValid:   false,
-			wantInvalid: []string{" Invalid", "Invalid "},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: " Invalid"},
+				{genreIndex: 3, field: "name", value: "Invalid "},
+			},
 		},
 		{
 			name: "empty name",
 			genres: []data.GameGenre{
 				{Name: "", AltNames: nil},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 	}
 
@@ -260,14 +268,10 @@ func TestValidateNameTrimmed(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotInvalid := ValidateNameTrimmed(test.genres)
-
-			if gotValid != test.wantValid {
-				runner.Errorf("valid mismatch: got %v, want %v", gotValid, test.wantValid)
-			}
+			gotFindings := locationsOf(ValidateNameTrimmed(test.genres))
 
-			if !reflect.DeepEqual(gotInvalid, test.wantInvalid) {
-				runner.Errorf("invalid names mismatch: got %v, want %v", gotInvalid, test.wantInvalid)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -277,40 +281,41 @@ func TestValidateAltNamesTrimmed(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantInvalid []string
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:        "empty input",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantInvalid: nil,
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "all valid altNames",
 			genres: []data.GameGenre{
 				{Name: "RPG", AltNames: []string{"RolePlaying", "CRPG"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "leading/trailing whitespace",
 			genres: []data.GameGenre{
 				{Name: "Action", AltNames: []string{" Action ", "Arcade"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Action"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: " Action "},
+			},
 		},
 		{
 			name: "multiple invalid in one genre",
 			genres: []data.GameGenre{
 				{Name: "FPS", AltNames: []string{" FPS ", "Shooter ", "Gun "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"FPS"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: " FPS "},
+				{genreIndex: 0, field: "altNames[1]", value: "Shooter "},
+				{genreIndex: 0, field: "altNames[2]", value: "Gun "},
+			},
 		},
 		{
 			name: "mixed valid/invalid across genres",
@@ -319,8 +324,10 @@ func TestValidateAltNamesTrimmed(testRunner *testing.T) {
 				{Name: "MMO", AltNames: []string{"MassMultiplayer"}},
 				{Name: "RPG", AltNames: []string{" RPG", "RolePlay"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"RTS", "RPG"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "RealTime "},
+				{genreIndex: 2, field: "altNames[0]", value: " RPG"},
+			},
 		},
 		{
 			name: "special whitespace characters",
@@ -328,24 +335,29 @@ func TestValidateAltNamesTrimmed(testRunner *testing.T) {
 				{Name: "Tab", AltNames: []string{"\tIndented"}},
 				{Name: "Newline", AltNames: []string{"Line\n"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Tab", "Newline"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "\tIndented"},
+				{genreIndex: 1, field: "altNames[0]", value: "Line\n"},
+			},
 		},
 		{
 			name: "whitespace-only altName",
 			genres: []data.GameGenre{
 				{Name: "Empty", AltNames: []string{"  ", "\t\n"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Empty"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "  "},
+				{genreIndex: 0, field: "altNames[1]", value: "\t\n"},
+			},
 		},
 		{
 			name: "empty genre name with invalid alt",
 			genres: []data.GameGenre{
 				{Name: "", AltNames: []string{" Invalid "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{""},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: " Invalid "},
+			},
 		},
 		{
 			name: "multiple validation errors",
@@ -354,16 +366,17 @@ func TestValidateAltNamesTrimmed(testRunner *testing.T) {
 				{Name: "B", AltNames: []string{"Perfect"}},
 				{Name: "C", AltNames: []string{" Problem ", "Issue"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"A", "C"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: " Valid"},
+				{genreIndex: 2, field: "altNames[0]", value: " Problem "},
+			},
 		},
 		{
 			name: "valid empty altName",
 			genres: []data.GameGenre{
 				{Name: "Strategy", AltNames: []string{""}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 	}
 
@@ -371,14 +384,140 @@ func TestValidateAltNamesTrimmed(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotInvalid := ValidateAltNamesTrimmed(test.genres)
+			gotFindings := locationsOf(ValidateAltNamesTrimmed(test.genres))
 
-			if gotValid != test.wantValid {
-				runner.Errorf("valid mismatch: got %v, want %v", gotValid, test.wantValid)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
+		})
+	}
+}
+
+func TestValidateNameSingleSpaced(testRunner *testing.T) {
+	testRunner.Parallel()
 
-			if !reflect.DeepEqual(gotInvalid, test.wantInvalid) {
-				runner.Errorf("invalid list mismatch: got %v, want %v", gotInvalid, test.wantInvalid)
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty slice",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
+		},
+		{
+			name: "valid names",
+			genres: []data.GameGenre{
+				{Name: "beat 'em up", AltNames: nil},
+				{Name: "run-n-gun", AltNames: nil},
+				{Name: "rpg", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "consecutive spaces",
+			genres: []data.GameGenre{
+				{Name: "beat  'em up", AltNames: nil},
+				{Name: "real-time   strategy", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "beat  'em up"},
+				{genreIndex: 1, field: "name", value: "real-time   strategy"},
+			},
+		},
+		{
+			name: "tabs and line breaks",
+			genres: []data.GameGenre{
+				{Name: "beat\t'em up", AltNames: nil},
+				{Name: "beat 'em\nup", AltNames: nil},
+				{Name: "beat 'em \r\nup", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "beat\t'em up"},
+				{genreIndex: 1, field: "name", value: "beat 'em\nup"},
+				{genreIndex: 2, field: "name", value: "beat 'em \r\nup"},
+			},
+		},
+		{
+			name: "leading and trailing whitespace is left to the trimmed rule",
+			genres: []data.GameGenre{
+				{Name: "  rpg", AltNames: nil},
+				{Name: "rpg\t", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "single non-ASCII space is left to the confusable characters rule",
+			genres: []data.GameGenre{
+				{Name: "beat 'em\u00A0up", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateNameSingleSpaced(test.genres))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestValidateAltNamesSingleSpaced(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
+		},
+		{
+			name: "valid alternative names",
+			genres: []data.GameGenre{
+				{Name: "beat 'em up", AltNames: []string{"brawler", "beat-'em-up"}},
+				{Name: "rpg", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "irregular whitespace",
+			genres: []data.GameGenre{
+				{Name: "beat 'em up", AltNames: []string{"brawler", "beat 'em  up"}},
+				{Name: "rpg", AltNames: []string{"role-playing\tgame", " role-playing game "}},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "beat 'em  up"},
+				{genreIndex: 1, field: "altNames[0]", value: "role-playing\tgame"},
+			},
+		},
+		{
+			name: "name is not checked",
+			genres: []data.GameGenre{
+				{Name: "beat  'em up", AltNames: []string{"brawler"}},
+			},
+			wantFindings: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateAltNamesSingleSpaced(test.genres))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -388,22 +527,21 @@ func TestValidateNameCase(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name          string
-		input         []data.GameGenre
-		expectedValid bool
-		expectedNames []string
+		name         string
+		input        []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:          "all lowercase",
-			input:         []data.GameGenre{{Name: "action", AltNames: nil}, {Name: "rpg", AltNames: nil}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "all lowercase",
+			input:        []data.GameGenre{{Name: "action", AltNames: nil}, {Name: "rpg", AltNames: nil}},
+			wantFindings: nil,
 		},
 		{
-			name:          "single uppercase",
-			input:         []data.GameGenre{{Name: "Action", AltNames: nil}},
-			expectedValid: false,
-			expectedNames: []string{"Action"},
+			name:  "single uppercase",
+			input: []data.GameGenre{{Name: "Action", AltNames: nil}},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "Action"},
+			},
 		},
 		{
 			name: "mixed casing",
@@ -412,38 +550,37 @@ func TestValidateNameCase(testRunner *testing.T) {
 				{Name: "RPG", AltNames: nil},
 				{Name: "FPS", AltNames: nil},
 			},
-			expectedValid: false,
-			expectedNames: []string{"RPG", "FPS"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "RPG"},
+				{genreIndex: 2, field: "name", value: "FPS"},
+			},
 		},
 		{
-			name:          "empty input",
-			input:         []data.GameGenre{},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "empty input",
+			input:        []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
-			name:          "unicode characters",
-			input:         []data.GameGenre{{Name: "アクション", AltNames: nil}, {Name: "アクション", AltNames: nil}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "unicode characters",
+			input:        []data.GameGenre{{Name: "アクション", AltNames: nil}, {Name: "アクション", AltNames: nil}},
+			wantFindings: nil,
 		},
 		{
-			name:          "numbers and symbols",
-			input:         []data.GameGenre{{Name: "game-2", AltNames: nil}, {Name: "mod!", AltNames: nil}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "numbers and symbols",
+			input:        []data.GameGenre{{Name: "game-2", AltNames: nil}, {Name: "mod!", AltNames: nil}},
+			wantFindings: nil,
 		},
 		{
-			name:          "case in middle",
-			input:         []data.GameGenre{{Name: "actionGame", AltNames: nil}},
-			expectedValid: false,
-			expectedNames: []string{"actionGame"},
+			name:  "case in middle",
+			input: []data.GameGenre{{Name: "actionGame", AltNames: nil}},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "name", value: "actionGame"},
+			},
 		},
 		{
-			name:          "empty name",
-			input:         []data.GameGenre{{Name: "", AltNames: nil}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "empty name",
+			input:        []data.GameGenre{{Name: "", AltNames: nil}},
+			wantFindings: nil,
 		},
 	}
 
@@ -451,21 +588,10 @@ func TestValidateNameCase(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			valid, names := ValidateNameCase(test.input)
+			gotFindings := locationsOf(ValidateNameCase(test.input))
 
-			if valid != test.expectedValid {
-				runner.Errorf("valid mismatch: got %v, want %v", valid, test.expectedValid)
-			}
-
-			if len(names) != len(test.expectedNames) {
-				runner.Fatalf("names length mismatch: got %d, want %d", len(names), len(test.expectedNames))
-			}
-
-			for nameIndex := range names {
-				if names[nameIndex] != test.expectedNames[nameIndex] {
-					runner.Errorf("name mismatch at index %d: got %q, want %q", nameIndex, names[nameIndex],
-						test.expectedNames[nameIndex])
-				}
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -475,16 +601,14 @@ func TestValidateAltNamesCase(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name          string
-		input         []data.GameGenre
-		expectedValid bool
-		expectedNames []string
+		name         string
+		input        []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:          "all lowercase altnames",
-			input:         []data.GameGenre{{Name: "", AltNames: []string{"action", "rpg"}}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "all lowercase altnames",
+			input:        []data.GameGenre{{Name: "", AltNames: []string{"action", "rpg"}}},
+			wantFindings: nil,
 		},
 		{
 			name: "mixed case in multiple genres",
@@ -492,48 +616,52 @@ func TestValidateAltNamesCase(testRunner *testing.T) {
 				{Name: "", AltNames: []string{"Action", "platform"}},
 				{Name: "", AltNames: []string{"RPG", "Strategy"}},
 			},
-			expectedValid: false,
-			expectedNames: []string{"Action", "RPG", "Strategy"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "Action"},
+				{genreIndex: 1, field: "altNames[0]", value: "RPG"},
+				{genreIndex: 1, field: "altNames[1]", value: "Strategy"},
+			},
 		},
 		{
-			name:          "case in middle of word",
-			input:         []data.GameGenre{{Name: "", AltNames: []string{"actionGame"}}},
-			expectedValid: false,
-			expectedNames: []string{"actionGame"},
+			name:  "case in middle of word",
+			input: []data.GameGenre{{Name: "", AltNames: []string{"actionGame"}}},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "actionGame"},
+			},
 		},
 		{
-			name:          "empty altnames list",
-			input:         []data.GameGenre{{Name: "", AltNames: []string{}}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "empty altnames list",
+			input:        []data.GameGenre{{Name: "", AltNames: []string{}}},
+			wantFindings: nil,
 		},
 		{
-			name:          "empty string altname",
-			input:         []data.GameGenre{{Name: "", AltNames: []string{""}}},
-			expectedValid: true,
-			expectedNames: nil,
+			name:         "empty string altname",
+			input:        []data.GameGenre{{Name: "", AltNames: []string{""}}},
+			wantFindings: nil,
 		},
 		{
 			name: "unicode characters",
 			input: []data.GameGenre{
 				{Name: "", AltNames: []string{"ÄCTION", "ßpecial"}},
 			},
-			expectedValid: false,
-			expectedNames: []string{"ÄCTION"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "ÄCTION"},
+			},
 		},
 		{
 			name: "special characters and numbers",
 			input: []data.GameGenre{
 				{Name: "", AltNames: []string{"mod!", "game2"}},
 			},
-			expectedValid: true,
-			expectedNames: nil,
+			wantFindings: nil,
 		},
 		{
-			name:          "multiple duplicates",
-			input:         []data.GameGenre{{Name: "", AltNames: []string{"Action", "Action"}}},
-			expectedValid: false,
-			expectedNames: []string{"Action", "Action"},
+			name:  "multiple duplicates",
+			input: []data.GameGenre{{Name: "", AltNames: []string{"Action", "Action"}}},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[0]", value: "Action"},
+				{genreIndex: 0, field: "altNames[1]", value: "Action"},
+			},
 		},
 	}
 
@@ -541,14 +669,10 @@ func TestValidateAltNamesCase(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			valid, names := ValidateAltNamesCase(test.input)
-
-			if valid != test.expectedValid {
-				runner.Errorf("validity mismatch: got %v, want %v", valid, test.expectedValid)
-			}
+			gotFindings := locationsOf(ValidateAltNamesCase(test.input))
 
-			if !reflect.DeepEqual(names, test.expectedNames) {
-				runner.Errorf("invalid names mismatch:\ngot:  %v\nwant: %v", names, test.expectedNames)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -558,24 +682,21 @@ func TestValidateNameUnique(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name           string
-		gameGenres     []data.GameGenre
-		wantValid      bool
-		wantDuplicates []string
+		name         string
+		gameGenres   []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:           "empty slice",
-			gameGenres:     []data.GameGenre{},
-			wantValid:      true,
-			wantDuplicates: nil,
+			name:         "empty slice",
+			gameGenres:   []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "single genre",
 			gameGenres: []data.GameGenre{
 				{Name: "RPG", AltNames: nil},
 			},
-			wantValid:      true,
-			wantDuplicates: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "two genres with same name",
@@ -583,8 +704,9 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "RPG", AltNames: nil},
 				{Name: "RPG", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{"RPG"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "RPG"},
+			},
 		},
 		{
 			name: "three genres with same name",
@@ -593,8 +715,10 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "Action", AltNames: nil},
 				{Name: "Action", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{"Action"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "Action"},
+				{genreIndex: 2, field: "name", value: "Action"},
+			},
 		},
 		{
 			name: "case sensitive names",
@@ -602,8 +726,7 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "rpg", AltNames: nil},
 				{Name: "RPG", AltNames: nil},
 			},
-			wantValid:      true,
-			wantDuplicates: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "multiple duplicate groups",
@@ -614,8 +737,10 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "Action", AltNames: nil},
 				{Name: "Simulation", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{"Action", "RPG"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "RPG"},
+				{genreIndex: 3, field: "name", value: "Action"},
+			},
 		},
 		{
 			name: "mixed duplicates and unique",
@@ -625,8 +750,9 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "Action", AltNames: nil},
 				{Name: "Strategy", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{"RPG"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "RPG"},
+			},
 		},
 		{
 			name: "empty name duplicates",
@@ -634,8 +760,9 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "", AltNames: nil},
 				{Name: "", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{""},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: ""},
+			},
 		},
 		{
 			name: "whitespace names",
@@ -643,8 +770,7 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: " RPG ", AltNames: nil},
 				{Name: "RPG", AltNames: nil},
 			},
-			wantValid:      true,
-			wantDuplicates: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "multiple duplicates with non-duplicates",
@@ -655,16 +781,17 @@ func TestValidateNameUnique(testRunner *testing.T) {
 				{Name: "Action", AltNames: nil},
 				{Name: "Simulation", AltNames: nil},
 			},
-			wantValid:      false,
-			wantDuplicates: []string{"Action", "RPG"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "RPG"},
+				{genreIndex: 3, field: "name", value: "Action"},
+			},
 		},
 		{
 			name: "single empty name",
 			gameGenres: []data.GameGenre{
 				{Name: "", AltNames: nil},
 			},
-			wantValid:      true,
-			wantDuplicates: nil,
+			wantFindings: nil,
 		},
 	}
 
@@ -672,20 +799,10 @@ func TestValidateNameUnique(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotDuplicates := ValidateNameUnique(test.gameGenres)
-
-			if gotValid != test.wantValid {
-				runner.Errorf("got valid %v, want %v", gotValid, test.wantValid)
-			}
-
-			sort.Strings(gotDuplicates)
-
-			sortedWant := append([]string(nil), test.wantDuplicates...)
+			gotFindings := locationsOf(ValidateNameUnique(test.gameGenres))
 
-			sort.Strings(sortedWant)
-
-			if !reflect.DeepEqual(gotDuplicates, sortedWant) {
-				runner.Errorf("got duplicates %v, want %v", gotDuplicates, test.wantDuplicates)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -695,16 +812,14 @@ func TestValidateAltNamesUnique(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantInvalid []string
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
 	}{
 		{
-			name:        "empty input",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantInvalid: nil,
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "no duplicates",
@@ -712,40 +827,40 @@ func TestValidateAltNamesUnique(testRunner *testing.T) {
 				{Name: "RPG", AltNames: []string{"CRPG", "RolePlaying"}},
 				{Name: "FPS", AltNames: []string{"Shooter", "FPS"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "single duplicate in one genre",
 			genres: []data.GameGenre{
 				{Name: "RTS", AltNames: []string{"Strategy", "Strategy", "RTS"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"RTS"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "Strategy"},
+			},
 		},
 		{
 			name: "case sensitivity no duplicates",
 			genres: []data.GameGenre{
 				{Name: "Action", AltNames: []string{"action", "ACTION"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "whitespace differences no duplicates",
 			genres: []data.GameGenre{
 				{Name: "RPG", AltNames: []string{"RPG ", " RPG", "RPG"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "multiple duplicates in one genre",
 			genres: []data.GameGenre{
 				{Name: "GenreA", AltNames: []string{"A", "A", "B", "B"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"GenreA"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "A"},
+				{genreIndex: 0, field: "altNames[3]", value: "B"},
+			},
 		},
 		{
 			name: "multiple genres with duplicates",
@@ -753,24 +868,24 @@ func TestValidateAltNamesUnique(testRunner *testing.T) {
 				{Name: "Genre1", AltNames: []string{"X", "X"}},
 				{Name: "Genre2", AltNames: []string{"Y", "Y", "Z"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Genre1", "Genre2"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "X"},
+				{genreIndex: 1, field: "altNames[1]", value: "Y"},
+			},
 		},
 		{
 			name: "empty altNames list",
 			genres: []data.GameGenre{
 				{Name: "Empty", AltNames: []string{}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "single altName",
 			genres: []data.GameGenre{
 				{Name: "Single", AltNames: []string{"Only"}},
 			},
-			wantValid:   true,
-			wantInvalid: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "mixed valid and invalid genres",
@@ -779,32 +894,37 @@ func TestValidateAltNamesUnique(testRunner *testing.T) {
 				{Name: "Invalid", AltNames: []string{"C", "C"}},
 				{Name: "Valid2", AltNames: []string{"D", "E"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Invalid"},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "altNames[1]", value: "C"},
+			},
 		},
 		{
 			name: "duplicates with empty genre name",
 			genres: []data.GameGenre{
 				{Name: "", AltNames: []string{"X", "X"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{""},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "X"},
+			},
 		},
 		{
 			name: "whitespace only duplicates",
 			genres: []data.GameGenre{
 				{Name: "Space", AltNames: []string{"  ", "  ", " "}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"Space"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "  "},
+			},
 		},
 		{
 			name: "all altNames duplicated",
 			genres: []data.GameGenre{
 				{Name: "AllDuplicates", AltNames: []string{"A", "A", "A"}},
 			},
-			wantValid:   false,
-			wantInvalid: []string{"AllDuplicates"},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "A"},
+				{genreIndex: 0, field: "altNames[2]", value: "A"},
+			},
 		},
 	}
 
@@ -812,14 +932,10 @@ func TestValidateAltNamesUnique(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotInvalid := ValidateAltNamesUnique(test.genres)
+			gotFindings := locationsOf(ValidateAltNamesUnique(test.genres))
 
-			if gotValid != test.wantValid {
-				runner.Errorf("validity mismatch: got %v, want %v", gotValid, test.wantValid)
-			}
-
-			if !reflect.DeepEqual(gotInvalid, test.wantInvalid) {
-				runner.Errorf("invalid list mismatch: got %v, want %v", gotInvalid, test.wantInvalid)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("findings mismatch:\ngot:  %+v\nwant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -829,16 +945,14 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantCollide []GenreWithCollidingAltName
+		name         string
+		genres       []data.GameGenre
+		wantFindings []Finding
 	}{
 		{
-			name:        "empty input",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantCollide: nil,
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "no collisions",
@@ -846,17 +960,15 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "RPG", AltNames: []string{"CRPG"}},
 				{Name: "FPS", AltNames: []string{"Shooter"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "self collision",
 			genres: []data.GameGenre{
 				{Name: "RPG", AltNames: []string{"RPG"}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"RPG", "RPG"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "RPG", 0, "RPG", `alternative name is also the name of genre "RPG"`),
 			},
 		},
 		{
@@ -865,9 +977,8 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "RPG", AltNames: []string{"CRPG"}},
 				{Name: "CRPG", AltNames: []string{}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"CRPG", "RPG"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "RPG", 0, "CRPG", `alternative name is also the name of genre "CRPG"`),
 			},
 		},
 		{
@@ -877,11 +988,10 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "B", AltNames: []string{"C"}},
 				{Name: "C", AltNames: []string{"A"}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"A", "C"},
-				{"B", "A"},
-				{"C", "B"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "B", `alternative name is also the name of genre "B"`),
+				newAltNameFinding(1, "B", 0, "C", `alternative name is also the name of genre "C"`),
+				newAltNameFinding(2, "C", 0, "A", `alternative name is also the name of genre "A"`),
 			},
 		},
 		{
@@ -890,9 +1000,8 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "Rpg", AltNames: []string{"RPG"}},
 				{Name: "rpg", AltNames: []string{"Rpg"}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"Rpg", "rpg"},
+			wantFindings: []Finding{
+				newAltNameFinding(1, "rpg", 0, "Rpg", `alternative name is also the name of genre "Rpg"`),
 			},
 		},
 		{
@@ -901,9 +1010,8 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "Action", AltNames: []string{" Action "}},
 				{Name: "Action ", AltNames: []string{"Action"}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"Action", "Action "},
+			wantFindings: []Finding{
+				newAltNameFinding(1, "Action ", 0, "Action", `alternative name is also the name of genre "Action"`),
 			},
 		},
 		{
@@ -912,10 +1020,9 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "", AltNames: []string{""}},
 				{Name: "Empty", AltNames: []string{""}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"", ""},
-				{"", "Empty"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "", 0, "", `alternative name is also the name of genre ""`),
+				newAltNameFinding(1, "Empty", 0, "", `alternative name is also the name of genre ""`),
 			},
 		},
 		{
@@ -925,10 +1032,9 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "B", AltNames: []string{"X"}},
 				{Name: "X", AltNames: []string{}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"X", "A"},
-				{"X", "B"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "X", `alternative name is also the name of genre "X"`),
+				newAltNameFinding(1, "B", 0, "X", `alternative name is also the name of genre "X"`),
 			},
 		},
 		{
@@ -938,11 +1044,10 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 				{Name: "B", AltNames: []string{"D"}},
 				{Name: "D", AltNames: []string{"A"}},
 			},
-			wantValid: false,
-			wantCollide: []GenreWithCollidingAltName{
-				{"A", "D"},
-				{"B", "A"},
-				{"D", "B"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "B", `alternative name is also the name of genre "B"`),
+				newAltNameFinding(1, "B", 0, "D", `alternative name is also the name of genre "D"`),
+				newAltNameFinding(2, "D", 0, "A", `alternative name is also the name of genre "A"`),
 			},
 		},
 		{
@@ -950,8 +1055,18 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 			genres: []data.GameGenre{
 				{Name: "RPG", AltNames: []string{"CRPG", "CRPG"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
+		},
+		{
+			name: "alt name of several genres with the same name",
+			genres: []data.GameGenre{
+				{Name: "RPG", AltNames: []string{"CRPG"}},
+				{Name: "CRPG", AltNames: []string{}},
+				{Name: "CRPG", AltNames: []string{}},
+			},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "RPG", 0, "CRPG", `alternative name is also the name of genre "CRPG"`),
+			},
 		},
 	}
 
@@ -959,14 +1074,10 @@ func TestValidateGenreNameNoCollisionsWithAltNames(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotCollide := ValidateGenreNameNoCollisionsWithAltNames(test.genres)
-
-			if gotValid != test.wantValid {
-				runner.Errorf("Validity mismatch: got %v, want %v", gotValid, test.wantValid)
-			}
+			gotFindings := ValidateGenreNameNoCollisionsWithAltNames(test.genres)
 
-			if !reflect.DeepEqual(gotCollide, test.wantCollide) {
-				runner.Errorf("Collisions mismatch:\nGot: %+v\nWant: %+v", gotCollide, test.wantCollide)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("Collisions mismatch:\nGot: %+v\nWant: %+v", gotFindings, test.wantFindings)
 			}
 		})
 	}
@@ -976,16 +1087,14 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 	testRunner.Parallel()
 
 	tests := []struct {
-		name        string
-		genres      []data.GameGenre
-		wantValid   bool
-		wantCollide []AltNameCollision
+		name         string
+		genres       []data.GameGenre
+		wantFindings []Finding
 	}{
 		{
-			name:        "empty input",
-			genres:      []data.GameGenre{},
-			wantValid:   true,
-			wantCollide: nil,
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
 		},
 		{
 			name: "no collisions",
@@ -993,16 +1102,14 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{"a1"}},
 				{Name: "B", AltNames: []string{"b1"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "self collision with duplicate alt names",
 			genres: []data.GameGenre{
 				{Name: "A", AltNames: []string{"x", "x"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "cross-genre collision",
@@ -1010,10 +1117,9 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{"x"}},
 				{Name: "B", AltNames: []string{"x"}},
 			},
-			wantValid: false,
-			wantCollide: []AltNameCollision{
-				{"x", "A", "B"},
-				{"x", "B", "A"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "x", `alternative name is also an alternative name of genre "B"`),
+				newAltNameFinding(1, "B", 0, "x", `alternative name is also an alternative name of genre "A"`),
 			},
 		},
 		{
@@ -1022,8 +1128,7 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{"RPG"}},
 				{Name: "B", AltNames: []string{"rpg"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "whitespace differences",
@@ -1031,8 +1136,7 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{" RPG "}},
 				{Name: "B", AltNames: []string{"RPG"}},
 			},
-			wantValid:   true,
-			wantCollide: nil,
+			wantFindings: nil,
 		},
 		{
 			name: "empty alt names",
@@ -1040,10 +1144,9 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{""}},
 				{Name: "B", AltNames: []string{""}},
 			},
-			wantValid: false,
-			wantCollide: []AltNameCollision{
-				{"", "A", "B"},
-				{"", "B", "A"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "", `alternative name is also an alternative name of genre "B"`),
+				newAltNameFinding(1, "B", 0, "", `alternative name is also an alternative name of genre "A"`),
 			},
 		},
 		{
@@ -1053,14 +1156,13 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "B", AltNames: []string{"x"}},
 				{Name: "C", AltNames: []string{"x"}},
 			},
-			wantValid: false,
-			wantCollide: []AltNameCollision{
-				{"x", "A", "B"},
-				{"x", "A", "C"},
-				{"x", "B", "A"},
-				{"x", "B", "C"},
-				{"x", "C", "A"},
-				{"x", "C", "B"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "x", `alternative name is also an alternative name of genre "B"`),
+				newAltNameFinding(0, "A", 0, "x", `alternative name is also an alternative name of genre "C"`),
+				newAltNameFinding(1, "B", 0, "x", `alternative name is also an alternative name of genre "A"`),
+				newAltNameFinding(1, "B", 0, "x", `alternative name is also an alternative name of genre "C"`),
+				newAltNameFinding(2, "C", 0, "x", `alternative name is also an alternative name of genre "A"`),
+				newAltNameFinding(2, "C", 0, "x", `alternative name is also an alternative name of genre "B"`),
 			},
 		},
 		{
@@ -1069,10 +1171,9 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 				{Name: "A", AltNames: []string{"x", "y"}},
 				{Name: "B", AltNames: []string{"x", "z"}},
 			},
-			wantValid: false,
-			wantCollide: []AltNameCollision{
-				{"x", "A", "B"},
-				{"x", "B", "A"},
+			wantFindings: []Finding{
+				newAltNameFinding(0, "A", 0, "x", `alternative name is also an alternative name of genre "B"`),
+				newAltNameFinding(1, "B", 0, "x", `alternative name is also an alternative name of genre "A"`),
 			},
 		},
 	}
@@ -1081,15 +1182,44 @@ func TestValidateCollidingAltNames(testRunner *testing.T) {
 		testRunner.Run(test.name, func(runner *testing.T) {
 			runner.Parallel()
 
-			gotValid, gotCollide := ValidateCollidingAltNames(test.genres)
+			gotFindings := ValidateCollidingAltNames(test.genres)
 
-			if gotValid != test.wantValid {
-				runner.Errorf("Validity mismatch: got %v, want %v", gotValid, test.wantValid)
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("Collision mismatch:\nGot: %+v\nWant: %+v", gotFindings, test.wantFindings)
 			}
+		})
+	}
+}
 
-			if !reflect.DeepEqual(gotCollide, test.wantCollide) {
-				runner.Errorf("Collision mismatch:\nGot: %+v\nWant: %+v", gotCollide, test.wantCollide)
-			}
+// findingLocation is the part of a Finding that tells which value of which genre is invalid.
+type findingLocation struct {
+	genreIndex int
+	field      string
+	value      string
+}
+
+func locationsOf(findings []Finding) []findingLocation {
+	var locations []findingLocation
+
+	for _, finding := range findings {
+		locations = append(locations, findingLocation{
+			genreIndex: finding.GenreIndex,
+			field:      finding.Field,
+			value:      finding.Value,
 		})
 	}
+
+	return locations
+}
+
+// messagesOf returns the subjects and messages of the findings, which tells both which value is invalid and what it
+// collides with.
+func messagesOf(findings []Finding) []string {
+	var messages []string
+
+	for _, finding := range findings {
+		messages = append(messages, finding.Subject()+": "+finding.Message)
+	}
+
+	return messages
 }
@@ -0,0 +1,154 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+var (
+	errUnknownOption     = errors.New("unknown option")
+	errInvalidOptionType = errors.New("invalid option type")
+)
+
+// Options holds rule-specific settings, keyed by option name.
+//
+// Values are one of bool, float64, string or []string, which are the types JSON values of a configuration file are
+// decoded into.
+type Options map[string]any
+
+// Bool returns the boolean option with the given name, or false if the option is not set.
+func (options Options) Bool(name string) bool {
+	value, _ := options[name].(bool)
+
+	return value
+}
+
+// Float returns the numeric option with the given name, or 0 if the option is not set.
+func (options Options) Float(name string) float64 {
+	value, _ := options[name].(float64)
+
+	return value
+}
+
+// String returns the string option with the given name, or an empty string if the option is not set.
+func (options Options) String(name string) string {
+	value, _ := options[name].(string)
+
+	return value
+}
+
+// Strings returns the string list option with the given name, or nil if the option is not set.
+func (options Options) Strings(name string) []string {
+	value, _ := options[name].([]string)
+
+	return value
+}
+
+// With returns a copy of the options with the given values overridden.
+//
+// Parameters:
+//
+//	overrides: The new values, usually decoded from JSON. Lists are accepted as []any as long as all items are strings.
+//
+// Returns:
+//
+//	Options: A new Options value, the receiver is not modified
+//	error: An error if an override names an option that does not exist or has a type different from the default value
+//
+// Examples:
+//
+//	defaults := Options{"exceptions": []string{}}
+//
+//	options, err := defaults.With(map[string]any{"exceptions": []any{"RPG"}})
+//	// returns Options{"exceptions": []string{"RPG"}}, nil
+//
+//	options, err := defaults.With(map[string]any{"exception": []any{"RPG"}})
+//	// returns nil, error (unknown option "exception")
+//
+// Note:
+//
+//	Overrides are checked in the order of their names, so the error is about the same option on every run.
+func (options Options) With(overrides map[string]any) (Options, error) {
+	result := maps.Clone(options)
+
+	if result == nil {
+		result = Options{}
+	}
+
+	names := make([]string, 0, len(overrides))
+
+	for name := range overrides {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		override := overrides[name]
+		defaultValue, ok := options[name]
+
+		if !ok {
+			return nil, fmt.Errorf("%w %q", errUnknownOption, name)
+		}
+
+		value, ok := convertOptionValue(override, defaultValue)
+
+		if !ok {
+			return nil, fmt.Errorf("%w: option %q must be of type %T, got %T", errInvalidOptionType, name, defaultValue,
+				override)
+		}
+
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+func convertOptionValue(value any, defaultValue any) (any, bool) {
+	switch defaultValue.(type) {
+	case bool:
+		converted, ok := value.(bool)
+
+		return converted, ok
+	case float64:
+		converted, ok := value.(float64)
+
+		return converted, ok
+	case string:
+		converted, ok := value.(string)
+
+		return converted, ok
+	case []string:
+		return convertStringList(value)
+	default:
+		return nil, false
+	}
+}
+
+func convertStringList(value any) ([]string, bool) {
+	if list, ok := value.([]string); ok {
+		return list, true
+	}
+
+	items, ok := value.([]any)
+
+	if !ok {
+		return nil, false
+	}
+
+	list := make([]string, 0, len(items))
+
+	for _, item := range items {
+		text, ok := item.(string)
+
+		if !ok {
+			return nil, false
+		}
+
+		list = append(list, text)
+	}
+
+	return list, true
+}
@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOptionsWith(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	defaults := Options{
+		"enabled":    true,
+		"threshold":  0.5,
+		"convention": "strip",
+		"exceptions": []string{},
+	}
+
+	tests := []struct {
+		name      string
+		overrides map[string]any
+		want      Options
+		wantError bool
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+			want:      defaults,
+			wantError: false,
+		},
+		{
+			name: "all types overridden",
+			overrides: map[string]any{
+				"enabled":    false,
+				"threshold":  0.2,
+				"convention": "keep",
+				"exceptions": []any{"RPG", "MMO"},
+			},
+			want: Options{
+				"enabled":    false,
+				"threshold":  0.2,
+				"convention": "keep",
+				"exceptions": []string{"RPG", "MMO"},
+			},
+			wantError: false,
+		},
+		{
+			name:      "unknown option",
+			overrides: map[string]any{"exception": []any{"RPG"}},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name:      "wrong type",
+			overrides: map[string]any{"threshold": "high"},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name:      "list with non-string items",
+			overrides: map[string]any{"exceptions": []any{"RPG", 1.0}},
+			want:      nil,
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			got, err := defaults.With(test.overrides)
+
+			if (err != nil) != test.wantError {
+				runner.Fatalf("got error %v, want error %v", err, test.wantError)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				runner.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestOptionsWithChecksOverridesInNameOrder(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	defaults := Options{"threshold": 0.5}
+	overrides := map[string]any{"zeta": 1.0, "threshold": "high", "alpha": 1.0, "beta": 1.0}
+
+	for range 20 {
+		_, err := defaults.With(overrides)
+
+		if err == nil || err.Error() != `unknown option "alpha"` {
+			testRunner.Fatalf(`got error %v, want unknown option "alpha"`, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"content_validator/internal/collation"
+	"content_validator/internal/data"
+	"fmt"
+)
+
+func init() {
+	Register(Rule{
+		ID:              "genres-sorted",
+		Description:     "Genres must be sorted alphabetically by name",
+		Hint:            "run `content_validator fmt` to sort the genres",
+		Category:        CategoryOrder,
+		DefaultSeverity: SeverityWarning,
+		Check:           ignoringOptions(ValidateGenresSorted),
+	})
+
+	Register(Rule{
+		ID:              "alt-names-sorted",
+		Description:     "Alternative names of every genre must be sorted alphabetically",
+		Hint:            "run `content_validator fmt` to sort the alternative names",
+		Category:        CategoryOrder,
+		DefaultSeverity: SeverityWarning,
+		Check:           ignoringOptions(ValidateAltNamesSorted),
+	})
+}
+
+// ValidateGenresSorted checks if the game genres are sorted alphabetically by name, in the order of
+// collation.Compare.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every genre that must be sorted before the genre preceding it, or nil if the genres are
+//	sorted
+//
+// Examples:
+//
+//	ValidateGenresSorted([]data.GameGenre{{Name: "action"}, {Name: "bishōjo"}, {Name: "bishoujo"}})  // returns nil
+//
+//	ValidateGenresSorted([]data.GameGenre{{Name: "racing"}, {Name: "action"}, {Name: "adventure"}, {Name: "4x"}})
+//	// returns findings for the genres at index 1 and 3
+//
+// Note:
+//
+//	Every genre is only compared with the genre preceding it, so a single misplaced genre is reported once instead
+//	of making every genre after it look misplaced too.
+func ValidateGenresSorted(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for genreIndex := 1; genreIndex < len(genres); genreIndex++ {
+		previousGenre, genre := genres[genreIndex-1], genres[genreIndex]
+
+		if collation.Compare(previousGenre.Name, genre.Name) > 0 {
+			findings = append(findings, newNameFinding(genreIndex, genre.Name,
+				fmt.Sprintf("genre name is not in alphabetical order, it must be sorted before genre #%d %q "+
+					"(run `content_validator fmt` to sort the genres)", genreIndex-1, previousGenre.Name)))
+		}
+	}
+
+	return findings
+}
+
+// ValidateAltNamesSorted checks if the alternative names of every game genre are sorted alphabetically, in the order
+// of collation.Compare.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for the first alternative name out of order in every genre, or nil if all lists are sorted
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "adventure", AltNames: []string{"adventure game", "graphic adventure"}},
+//	}
+//
+//	ValidateAltNamesSorted(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "adventure", AltNames: []string{"graphic adventure", "adventure game"}},
+//	}
+//
+//	ValidateAltNamesSorted(invalidGenres)  // returns a finding for altNames[1] of "adventure"
+func ValidateAltNamesSorted(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for genreIndex, genre := range genres {
+		for altNameIndex := 1; altNameIndex < len(genre.AltNames); altNameIndex++ {
+			previousAltName, altName := genre.AltNames[altNameIndex-1], genre.AltNames[altNameIndex]
+
+			if collation.Compare(previousAltName, altName) > 0 {
+				findings = append(findings, newAltNameFinding(genreIndex, genre.Name, altNameIndex, altName,
+					fmt.Sprintf("alternative name is not in alphabetical order, it must be sorted before altNames[%d] %q "+
+						"(run `content_validator fmt` to sort the alternative names)", altNameIndex-1, previousAltName)))
+
+				break
+			}
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,149 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateGenresSorted(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
+		},
+		{
+			name: "sorted genres",
+			genres: []data.GameGenre{
+				{Name: "4x", AltNames: nil},
+				{Name: "action", AltNames: nil},
+				{Name: "bishōjo", AltNames: nil},
+				{Name: "bishoujo", AltNames: nil},
+				{Name: "word construction", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "digits sorted after letters",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: nil},
+				{Name: "4x", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "4x"},
+			},
+		},
+		{
+			name: "diacritic sorted by its base letter",
+			genres: []data.GameGenre{
+				{Name: "bishoujo", AltNames: nil},
+				{Name: "bishōjo", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "bishōjo"},
+			},
+		},
+		{
+			name: "a single misplaced genre is reported once",
+			genres: []data.GameGenre{
+				{Name: "racing", AltNames: nil},
+				{Name: "action", AltNames: nil},
+				{Name: "adventure", AltNames: nil},
+				{Name: "shooter", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "action"},
+			},
+		},
+		{
+			name: "every genre before its predecessor is reported",
+			genres: []data.GameGenre{
+				{Name: "racing", AltNames: nil},
+				{Name: "action", AltNames: nil},
+				{Name: "adventure", AltNames: nil},
+				{Name: "4x", AltNames: nil},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 1, field: "name", value: "action"},
+				{genreIndex: 3, field: "name", value: "4x"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateGenresSorted(test.genres))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("ValidateGenresSorted() = %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestValidateAltNamesSorted(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantFindings []findingLocation
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantFindings: nil,
+		},
+		{
+			name: "sorted and missing alt names",
+			genres: []data.GameGenre{
+				{Name: "adventure", AltNames: []string{"adventure game", "graphic adventure"}},
+				{Name: "bishōjo", AltNames: []string{"bishōjo game", "bishoujo game"}},
+				{Name: "racing", AltNames: nil},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "unsorted alt names",
+			genres: []data.GameGenre{
+				{Name: "adventure", AltNames: []string{"graphic adventure", "adventure game"}},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "adventure game"},
+			},
+		},
+		{
+			name: "only the first misplaced alt name of every genre is reported",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"c", "b", "a"}},
+				{Name: "adventure", AltNames: []string{"a", "b"}},
+				{Name: "racing", AltNames: []string{"b", "a"}},
+			},
+			wantFindings: []findingLocation{
+				{genreIndex: 0, field: "altNames[1]", value: "b"},
+				{genreIndex: 2, field: "altNames[1]", value: "a"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotFindings := locationsOf(ValidateAltNamesSorted(test.genres))
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("ValidateAltNamesSorted() = %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"fmt"
+	"slices"
+)
+
+// Category groups validation rules by the kind of problem they detect. Categories are ordered: rules of an earlier
+// category are always run before rules of a later one.
+type Category int
+
+const (
+	CategoryEmptiness Category = iota
+	CategoryWhitespace
+	CategoryUnicode
+	CategoryCase
+	CategoryInflection
+	CategoryConvention
+	CategoryUniqueness
+	CategoryCollision
+	CategorySimilarity
+	CategoryOrder
+)
+
+var categoryNames = map[Category]string{
+	CategoryEmptiness:  "emptiness",
+	CategoryWhitespace: "whitespace",
+	CategoryUnicode:    "unicode",
+	CategoryCase:       "case",
+	CategoryInflection: "inflection",
+	CategoryConvention: "convention",
+	CategoryUniqueness: "uniqueness",
+	CategoryCollision:  "collision",
+	CategorySimilarity: "similarity",
+	CategoryOrder:      "order",
+}
+
+func (category Category) String() string {
+	name, ok := categoryNames[category]
+
+	if !ok {
+		return fmt.Sprintf("category(%d)", int(category))
+	}
+
+	return name
+}
+
+// CheckFunc is the function a Rule runs against the game genres.
+//
+// Parameters:
+//
+//	dataset: The validated genres and their shared index, built once per run
+//	options: The rule's options, its defaults merged with the configured values
+//
+// Returns:
+//
+//	[]Finding: The problems found in the genres, or nil if the genres satisfy the rule
+type CheckFunc func(dataset *Dataset, options Options) []Finding
+
+// FixFunc corrects the value a finding of a Rule is about.
+//
+// Parameters:
+//
+//	value: The offending value (Finding.Value)
+//
+// Returns:
+//
+//	string: The corrected value
+//	bool: true if the value must be replaced with the corrected value, false if it must be removed instead. Only
+//	alternative names can be removed, a genre name is never removed.
+type FixFunc func(value string) (string, bool)
+
+// Rule describes a single validation check.
+//
+// Fields:
+//
+//	ID: A unique, stable, kebab-case identifier of the rule (e.g. "name-not-empty")
+//	Description: A short human-readable explanation of what the rule enforces
+//	Hint: A short suggestion on how to fix the findings of the rule, may be empty
+//	Category: The kind of problem the rule detects, also used to order rules
+//	DefaultSeverity: The severity of the findings reported by the rule
+//	Options: The options the rule accepts with their default values, may be nil if the rule has no options
+//	Check: The function that performs the validation
+//	Fix: The function that corrects the findings of the rule, nil if they can not be fixed automatically
+//	Disabled: Whether Run skips the rule. Opt-in rules are registered disabled and enabled by the configuration.
+type Rule struct {
+	ID              string
+	Description     string
+	Hint            string
+	Category        Category
+	DefaultSeverity Severity
+	Options         Options
+	Check           CheckFunc
+	Fix             FixFunc
+	Disabled        bool
+}
+
+var registeredRules []Rule
+
+// Register adds a rule to the registry. It is meant to be called from init functions of the files that define rules.
+//
+// Parameters:
+//
+//	rule: The rule to register
+//
+// Note:
+//
+//	The function panics if the rule has no ID, Check function or valid default severity, or if a rule with the same ID
+//	is already registered, since these are programming errors that must be caught as soon as the program starts.
+func Register(rule Rule) {
+	if rule.ID == "" || rule.Check == nil {
+		panic(fmt.Sprintf("validation: rule %q must have an ID and a Check function", rule.ID))
+	}
+
+	if !rule.DefaultSeverity.IsValid() {
+		panic(fmt.Sprintf("validation: rule %q has invalid default severity %q", rule.ID, rule.DefaultSeverity))
+	}
+
+	for _, registeredRule := range registeredRules {
+		if registeredRule.ID == rule.ID {
+			panic(fmt.Sprintf("validation: rule %q is already registered", rule.ID))
+		}
+	}
+
+	registeredRules = append(registeredRules, rule)
+}
+
+// Rules returns all registered rules in the order they must be run.
+//
+// Returns:
+//
+//	[]Rule: A copy of the registry sorted by category. Rules of the same category keep their registration order.
+func Rules() []Rule {
+	rules := slices.Clone(registeredRules)
+
+	slices.SortStableFunc(rules, func(a, b Rule) int {
+		return int(a.Category) - int(b.Category)
+	})
+
+	return rules
+}
+
+func ignoringOptions(validate func(genres []data.GameGenre) []Finding) CheckFunc {
+	return func(dataset *Dataset, _ Options) []Finding {
+		return validate(dataset.Genres)
+	}
+}
+
+func withDataset(validate func(dataset *Dataset) []Finding) CheckFunc {
+	return func(dataset *Dataset, _ Options) []Finding {
+		return validate(dataset)
+	}
+}
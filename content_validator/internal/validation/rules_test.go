@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestRules(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	rules := Rules()
+
+	if len(rules) == 0 {
+		testRunner.Fatal("no rules registered")
+	}
+
+	seenIDs := make(map[string]bool)
+
+	for index, rule := range rules {
+		if seenIDs[rule.ID] {
+			testRunner.Errorf("duplicate rule ID %q", rule.ID)
+		}
+
+		seenIDs[rule.ID] = true
+
+		if rule.Description == "" {
+			testRunner.Errorf("rule %q has no description", rule.ID)
+		}
+
+		if rule.Hint == "" {
+			testRunner.Errorf("rule %q has no hint", rule.ID)
+		}
+
+		if index > 0 && rules[index-1].Category > rule.Category {
+			testRunner.Errorf("rule %q (%s) is ordered after rule %q (%s)",
+				rule.ID, rule.Category, rules[index-1].ID, rules[index-1].Category)
+		}
+	}
+}
+
+func TestRulesCheck(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		ruleID       string
+		genres       []data.GameGenre
+		overrides    map[string]any
+		wantMessages []string
+	}{
+		{
+			name:         "empty name",
+			ruleID:       "name-not-empty",
+			genres:       []data.GameGenre{{Name: " ", AltNames: nil}},
+			wantMessages: []string{"genre name is empty"},
+		},
+		{
+			name:   "name collides with alt name",
+			ruleID: "name-alt-name-collision",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"act"}},
+				{Name: "adventure", AltNames: []string{"action"}},
+			},
+			wantMessages: []string{`alternative name is also the name of genre "action"`},
+		},
+		{
+			name:   "colliding alt names",
+			ruleID: "alt-name-collision",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"fighting"}},
+				{Name: "adventure", AltNames: []string{"fighting"}},
+			},
+			wantMessages: []string{
+				`alternative name is also an alternative name of genre "adventure"`,
+				`alternative name is also an alternative name of genre "action"`,
+			},
+		},
+		{
+			name:         "name not in lowercase",
+			ruleID:       "name-case",
+			genres:       []data.GameGenre{{Name: "RPG", AltNames: nil}},
+			wantMessages: []string{"genre name is not in lowercase"},
+		},
+		{
+			name:         "name case exception",
+			ruleID:       "name-case",
+			genres:       []data.GameGenre{{Name: "RPG", AltNames: nil}},
+			overrides:    map[string]any{"exceptions": []any{"RPG"}},
+			wantMessages: nil,
+		},
+		{
+			name:   "alt name case exception",
+			ruleID: "alt-names-case",
+			genres: []data.GameGenre{
+				{Name: "role-playing", AltNames: []string{"RPG", "Role-playing game"}},
+			},
+			overrides:    map[string]any{"exceptions": []any{"RPG"}},
+			wantMessages: []string{"alternative name is not in lowercase"},
+		},
+		{
+			name:   "near duplicate threshold",
+			ruleID: "near-duplicate-names",
+			genres: []data.GameGenre{
+				{Name: "esports", AltNames: nil},
+				{Name: "sports", AltNames: nil},
+			},
+			overrides: map[string]any{"threshold": 0.15},
+			wantMessages: []string{
+				`genre name is nearly the same as the name of genre "esports" (normalized edit distance 0.14)`,
+			},
+		},
+		{
+			name:         "plural name exception",
+			ruleID:       "name-singular",
+			genres:       []data.GameGenre{{Name: "text adventures", AltNames: nil}},
+			overrides:    map[string]any{"exceptions": []any{"adventures"}},
+			wantMessages: nil,
+		},
+		{
+			name:         "game suffix option",
+			ruleID:       "name-game-suffix",
+			genres:       []data.GameGenre{{Name: "walking simulator", AltNames: nil}},
+			overrides:    map[string]any{"suffix": " simulator"},
+			wantMessages: []string{`genre name ends with " simulator"`},
+		},
+		{
+			name:         "valid genres",
+			ruleID:       "alt-name-collision",
+			genres:       []data.GameGenre{{Name: "action", AltNames: []string{"act"}}},
+			wantMessages: nil,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			index := slices.IndexFunc(Rules(), func(rule Rule) bool {
+				return rule.ID == test.ruleID
+			})
+
+			if index < 0 {
+				runner.Fatalf("rule %q is not registered", test.ruleID)
+			}
+
+			rule := Rules()[index]
+
+			options, err := rule.Options.With(test.overrides)
+
+			if err != nil {
+				runner.Fatalf("failed to override options: %v", err)
+			}
+
+			var gotMessages []string
+
+			for _, finding := range rule.Check(NewDataset(test.genres), options) {
+				gotMessages = append(gotMessages, finding.Message)
+			}
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %v, want %v", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+)
+
+// RunOptions controls how Run executes the rules.
+//
+// Fields:
+//
+//	FailFast: If true, stop after the first rule that reported a failing finding instead of running the remaining ones
+//	FailOn: The lowest severity that makes a finding failing
+//	Suppressions: Known exceptions whose findings are not reported
+type RunOptions struct {
+	FailFast     bool
+	FailOn       Severity
+	Suppressions []Suppression
+}
+
+// Run checks the game genres against the given rules and collects every finding.
+//
+// Parameters:
+//
+//	rules: The rules to run, in the order they must be run (usually the result of Rules())
+//	genres: A slice of data.GameGenre objects to validate
+//	options: Options that control the run
+//
+// Returns:
+//
+//	[]Finding: The findings sorted with SortFindings, findings of the same field in the order the rules were run, or
+//	nil if all rules passed
+//
+// Examples:
+//
+//	findings := Run(Rules(), genres, RunOptions{FailFast: false, FailOn: SeverityError})
+//
+//	for _, finding := range findings {
+//	    log.Println(finding)
+//	}
+//
+// Note:
+//
+//	Disabled rules are skipped.
+//	The RuleID and Severity of every returned finding are set from the rule that reported it.
+//	Suppressed findings are dropped. A suppression of a rule that was run but that matched no finding is reported as
+//	a warning with the UnusedSuppressionRuleID rule ID, so stale exceptions get cleaned up.
+//	Findings below the FailOn severity and suppressed findings never stop a fail-fast run.
+func Run(rules []Rule, genres []data.GameGenre, options RunOptions) []Finding {
+	var findings []Finding
+
+	dataset := NewDataset(genres)
+	usedSuppressions := make([]bool, len(options.Suppressions))
+	ranRuleIDs := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+
+		ranRuleIDs[rule.ID] = true
+
+		ruleFindings := rule.Check(dataset, rule.Options)
+
+		for findingIndex := range ruleFindings {
+			ruleFindings[findingIndex].RuleID = rule.ID
+			ruleFindings[findingIndex].Severity = rule.DefaultSeverity
+		}
+
+		ruleFindings = suppressFindings(ruleFindings, options.Suppressions, usedSuppressions)
+		findings = append(findings, ruleFindings...)
+
+		if options.FailFast && len(ruleFindings) > 0 && rule.DefaultSeverity.AtLeast(options.FailOn) {
+			break
+		}
+	}
+
+	for suppressionIndex, suppression := range options.Suppressions {
+		if !usedSuppressions[suppressionIndex] && ranRuleIDs[suppression.RuleID] {
+			findings = append(findings, newUnusedSuppressionFinding(suppression))
+		}
+	}
+
+	SortFindings(findings)
+
+	return findings
+}
+
+// CountFindings counts the findings of each severity.
+//
+// Parameters:
+//
+//	findings: The findings to count
+//
+// Returns:
+//
+//	map[Severity]int: The number of findings of each severity. Severities without findings are absent.
+func CountFindings(findings []Finding) map[Severity]int {
+	counts := make(map[Severity]int)
+
+	for _, finding := range findings {
+		counts[finding.Severity]++
+	}
+
+	return counts
+}
+
+// HasFailures reports whether any of the findings is at least as serious as the threshold.
+//
+// Parameters:
+//
+//	findings: The findings to check
+//	failOn: The lowest severity that makes a finding failing
+//
+// Returns:
+//
+//	bool: true if at least one finding is failing, false otherwise
+func HasFailures(findings []Finding, failOn Severity) bool {
+	for _, finding := range findings {
+		if finding.Severity.AtLeast(failOn) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"slices"
+	"testing"
+)
+
+func TestRun(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	warningRule := Rule{
+		ID:              "test-warning",
+		Description:     "Reports every genre",
+		Category:        CategoryEmptiness,
+		DefaultSeverity: SeverityWarning,
+		Check: func(dataset *Dataset, _ Options) []Finding {
+			var findings []Finding
+
+			for genreIndex, genre := range dataset.Genres {
+				findings = append(findings, newNameFinding(genreIndex, genre.Name, "genre is reported"))
+			}
+
+			return findings
+		},
+	}
+
+	rulesWithWarning := append([]Rule{warningRule}, Rules()...)
+
+	disabledWarningRule := warningRule
+	disabledWarningRule.Disabled = true
+
+	tests := []struct {
+		name        string
+		rules       []Rule
+		genres      []data.GameGenre
+		options     RunOptions
+		wantRuleIDs []string
+	}{
+		{
+			name:        "valid genres",
+			rules:       Rules(),
+			genres:      []data.GameGenre{{Name: "action", AltNames: []string{"action game"}}},
+			options:     RunOptions{FailFast: false, FailOn: SeverityError},
+			wantRuleIDs: nil,
+		},
+		{
+			name:        "all failures reported",
+			rules:       Rules(),
+			genres:      []data.GameGenre{{Name: "Action ", AltNames: []string{"Act"}}},
+			options:     RunOptions{FailFast: false, FailOn: SeverityError},
+			wantRuleIDs: []string{"name-trimmed", "name-case", "alt-names-case"},
+		},
+		{
+			name:        "fail fast stops at first failure",
+			rules:       Rules(),
+			genres:      []data.GameGenre{{Name: "Action ", AltNames: []string{"Act"}}},
+			options:     RunOptions{FailFast: true, FailOn: SeverityError},
+			wantRuleIDs: []string{"name-trimmed"},
+		},
+		{
+			name:        "fail fast does not stop at warnings below threshold",
+			rules:       rulesWithWarning,
+			genres:      []data.GameGenre{{Name: "Action ", AltNames: []string{"Act"}}},
+			options:     RunOptions{FailFast: true, FailOn: SeverityError},
+			wantRuleIDs: []string{"test-warning", "name-trimmed"},
+		},
+		{
+			name:        "disabled rules are skipped",
+			rules:       append([]Rule{disabledWarningRule}, Rules()...),
+			genres:      []data.GameGenre{{Name: "Action ", AltNames: []string{"Act"}}},
+			options:     RunOptions{FailFast: false, FailOn: SeverityError},
+			wantRuleIDs: []string{"name-trimmed", "name-case", "alt-names-case"},
+		},
+		{
+			name:        "fail fast stops at warnings at threshold",
+			rules:       rulesWithWarning,
+			genres:      []data.GameGenre{{Name: "Action ", AltNames: []string{"Act"}}},
+			options:     RunOptions{FailFast: true, FailOn: SeverityWarning},
+			wantRuleIDs: []string{"test-warning"},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			findings := Run(test.rules, test.genres, test.options)
+
+			var gotRuleIDs []string
+
+			for _, finding := range findings {
+				gotRuleIDs = append(gotRuleIDs, finding.RuleID)
+			}
+
+			if !slices.Equal(gotRuleIDs, test.wantRuleIDs) {
+				runner.Errorf("got findings of rules %v, want %v", gotRuleIDs, test.wantRuleIDs)
+			}
+		})
+	}
+}
+
+func TestHasFailures(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name       string
+		severities []Severity
+		failOn     Severity
+		want       bool
+	}{
+		{
+			name:       "no findings",
+			severities: nil,
+			failOn:     SeverityWarning,
+			want:       false,
+		},
+		{
+			name:       "warnings only with error threshold",
+			severities: []Severity{SeverityWarning, SeverityInfo},
+			failOn:     SeverityError,
+			want:       false,
+		},
+		{
+			name:       "warnings only with warning threshold",
+			severities: []Severity{SeverityInfo, SeverityWarning},
+			failOn:     SeverityWarning,
+			want:       true,
+		},
+		{
+			name:       "error with error threshold",
+			severities: []Severity{SeverityError},
+			failOn:     SeverityError,
+			want:       true,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			var findings []Finding
+
+			for _, severity := range test.severities {
+				findings = append(findings, Finding{Severity: severity})
+			}
+
+			if got := HasFailures(findings, test.failOn); got != test.want {
+				runner.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
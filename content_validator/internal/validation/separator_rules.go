@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/textkey"
+	"fmt"
+)
+
+func init() {
+	Register(Rule{
+		ID: "separator-variant-collision",
+		Description: "Names and alternative names of different genres must not differ only by hyphens, spaces or " +
+			"apostrophes",
+		Hint:            "keep the spellings of the name in only one of the genres",
+		Category:        CategoryCollision,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateSeparatorCollisions),
+	})
+}
+
+// ValidateSeparatorCollisions checks if a name or an alternative name of a genre only differs from a name or an
+// alternative name of another genre by the hyphens, spaces and apostrophes between its words ("side-scroller" and
+// "side scroller", "beat 'em up" and "beat em up").
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every value with a separator variant in another genre, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "side-scroller", AltNames: []string{"side scroller", "sidescroller"}},
+//	}
+//
+//	ValidateSeparatorCollisions(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "run-n-gun", AltNames: []string{}},
+//	    {Name: "shooter", AltNames: []string{"run 'n' gun"}},
+//	}
+//
+//	ValidateSeparatorCollisions(invalidGenres)
+//	// returns findings for the name of "run-n-gun" and altNames[0] of "shooter"
+//
+// Note:
+//
+//	Values are compared without their separators (see textkey.WithoutSeparators), so repeated whitespace between
+//	words is ignored as well.
+//	Equal values are not reported, they are the collisions found by the uniqueness and collision rules.
+//	Every colliding value is reported once, with the first value it collides with.
+//	The findings are in file order.
+func ValidateSeparatorCollisions(genres []data.GameGenre) []Finding {
+	separatorKeys := func(value string) []string {
+		return []string{textkey.WithoutSeparators(value)}
+	}
+
+	return keyCollisions(genres, separatorKeys, func(value namedValue, otherValue namedValue) string {
+		return fmt.Sprintf("%s only differs by hyphens, spaces or apostrophes from %s", value.kind(),
+			otherValue.describe(genres))
+	})
+}
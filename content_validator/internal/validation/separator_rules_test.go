@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateSeparatorCollisions(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantMessages: nil,
+		},
+		{
+			name: "variants within a genre",
+			genres: []data.GameGenre{
+				{Name: "side-scroller", AltNames: []string{"side scroller", "sidescroller"}},
+				{Name: "point-and-click", AltNames: []string{"point and click"}},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "hyphens, spaces and apostrophes",
+			genres: []data.GameGenre{
+				{Name: "run-n-gun", AltNames: []string{}},
+				{Name: "beat 'em up", AltNames: []string{}},
+				{Name: "shooter", AltNames: []string{"run 'n' gun", "beat em  up"}},
+			},
+			wantMessages: []string{
+				`genre #0 "run-n-gun": genre name only differs by hyphens, spaces or apostrophes from alternative ` +
+					`name "run 'n' gun" of genre "shooter"`,
+				`genre #1 "beat 'em up": genre name only differs by hyphens, spaces or apostrophes from ` +
+					`alternative name "beat em  up" of genre "shooter"`,
+				`genre #2 "shooter", altNames[0] "run 'n' gun": alternative name only differs by hyphens, spaces ` +
+					`or apostrophes from the name of genre "run-n-gun"`,
+				`genre #2 "shooter", altNames[1] "beat em  up": alternative name only differs by hyphens, spaces ` +
+					`or apostrophes from the name of genre "beat 'em up"`,
+			},
+		},
+		{
+			name: "first colliding value per genre",
+			genres: []data.GameGenre{
+				{Name: "side-scroller", AltNames: []string{"side scroller"}},
+				{Name: "platformer", AltNames: []string{"sidescroller"}},
+			},
+			wantMessages: []string{
+				`genre #0 "side-scroller": genre name only differs by hyphens, spaces or apostrophes from ` +
+					`alternative name "sidescroller" of genre "platformer"`,
+				`genre #0 "side-scroller", altNames[0] "side scroller": alternative name only differs by hyphens, ` +
+					`spaces or apostrophes from alternative name "sidescroller" of genre "platformer"`,
+				`genre #1 "platformer", altNames[0] "sidescroller": alternative name only differs by hyphens, ` +
+					`spaces or apostrophes from the name of genre "side-scroller"`,
+			},
+		},
+		{
+			name: "one finding per value of a group",
+			genres: []data.GameGenre{
+				{Name: "run-n-gun", AltNames: []string{}},
+				{Name: "run n gun", AltNames: []string{}},
+				{Name: "runngun", AltNames: []string{}},
+				{Name: "run 'n' gun", AltNames: []string{}},
+			},
+			wantMessages: []string{
+				`genre #0 "run-n-gun": genre name only differs by hyphens, spaces or apostrophes from the name of ` +
+					`genre "run n gun"`,
+				`genre #1 "run n gun": genre name only differs by hyphens, spaces or apostrophes from the name of ` +
+					`genre "run-n-gun"`,
+				`genre #2 "runngun": genre name only differs by hyphens, spaces or apostrophes from the name of ` +
+					`genre "run-n-gun"`,
+				`genre #3 "run 'n' gun": genre name only differs by hyphens, spaces or apostrophes from the name of ` +
+					`genre "run-n-gun"`,
+			},
+		},
+		{
+			name: "equal values are left to the collision rules",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"run-n-gun"}},
+				{Name: "shooter", AltNames: []string{"run-n-gun"}},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "equal values colliding with another value",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"run-n-gun"}},
+				{Name: "run n gun", AltNames: []string{}},
+				{Name: "shooter", AltNames: []string{"run-n-gun"}},
+			},
+			wantMessages: []string{
+				`genre #0 "action", altNames[0] "run-n-gun": alternative name only differs by hyphens, spaces or ` +
+					`apostrophes from the name of genre "run n gun"`,
+				`genre #1 "run n gun": genre name only differs by hyphens, spaces or apostrophes from alternative ` +
+					`name "run-n-gun" of genre "action"`,
+				`genre #2 "shooter", altNames[0] "run-n-gun": alternative name only differs by hyphens, spaces or ` +
+					`apostrophes from the name of genre "run n gun"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotMessages := messagesOf(ValidateSeparatorCollisions(test.genres))
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Severity tells how serious a finding is.
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+var errUnknownSeverity = errors.New("unknown severity")
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:    0,
+	SeverityWarning: 1,
+	SeverityError:   2,
+}
+
+// ParseSeverity converts the textual form of a severity (e.g. from a command line flag) into a Severity.
+//
+// Parameters:
+//
+//	text: One of "info", "warning" or "error"
+//
+// Returns:
+//
+//	Severity: The parsed severity
+//	error: An error if the text is not a known severity
+//
+// Examples:
+//
+//	severity, err := ParseSeverity("warning")  // returns SeverityWarning, nil
+//
+//	severity, err := ParseSeverity("fatal")  // returns "", error
+func ParseSeverity(text string) (Severity, error) {
+	severity := Severity(text)
+
+	if !severity.IsValid() {
+		return "", fmt.Errorf("%w: %q (expected one of info, warning, error)", errUnknownSeverity, text)
+	}
+
+	return severity, nil
+}
+
+// IsValid reports whether the severity is one of the known severities.
+func (severity Severity) IsValid() bool {
+	_, ok := severityRanks[severity]
+
+	return ok
+}
+
+// AtLeast reports whether the severity is as serious as the threshold or more serious.
+//
+// Parameters:
+//
+//	threshold: The severity to compare with
+//
+// Returns:
+//
+//	bool: true if the severity is at least as serious as the threshold, false otherwise
+//
+// Examples:
+//
+//	SeverityError.AtLeast(SeverityWarning)  // returns true
+//	SeverityInfo.AtLeast(SeverityWarning)  // returns false
+func (severity Severity) AtLeast(threshold Severity) bool {
+	return severityRanks[severity] >= severityRanks[threshold]
+}
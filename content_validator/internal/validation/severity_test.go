@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestParseSeverity(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name      string
+		text      string
+		want      Severity
+		wantError bool
+	}{
+		{name: "info", text: "info", want: SeverityInfo, wantError: false},
+		{name: "warning", text: "warning", want: SeverityWarning, wantError: false},
+		{name: "error", text: "error", want: SeverityError, wantError: false},
+		{name: "unknown", text: "fatal", want: "", wantError: true},
+		{name: "wrong case", text: "Error", want: "", wantError: true},
+		{name: "empty", text: "", want: "", wantError: true},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			got, err := ParseSeverity(test.text)
+
+			if (err != nil) != test.wantError {
+				runner.Fatalf("got error %v, want error %v", err, test.wantError)
+			}
+
+			if got != test.want {
+				runner.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSeverityAtLeast(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		severity  Severity
+		threshold Severity
+		want      bool
+	}{
+		{severity: SeverityInfo, threshold: SeverityInfo, want: true},
+		{severity: SeverityInfo, threshold: SeverityWarning, want: false},
+		{severity: SeverityWarning, threshold: SeverityWarning, want: true},
+		{severity: SeverityWarning, threshold: SeverityError, want: false},
+		{severity: SeverityError, threshold: SeverityWarning, want: true},
+		{severity: SeverityError, threshold: SeverityError, want: true},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(string(test.severity)+" vs "+string(test.threshold), func(runner *testing.T) {
+			runner.Parallel()
+
+			if got := test.severity.AtLeast(test.threshold); got != test.want {
+				runner.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/editdistance"
+	"fmt"
+	"slices"
+)
+
+const (
+	// nearDuplicateThresholdOption is the highest normalized edit distance at which two names are reported as near
+	// duplicates.
+	nearDuplicateThresholdOption = "threshold"
+
+	// defaultNearDuplicateThreshold allows about one edit in ten characters, which catches a missing plural "s" or a
+	// typo in a two-word name but not genres that merely share a word ("esports" and "sports" are 0.14 apart).
+	defaultNearDuplicateThreshold = 0.1
+)
+
+func init() {
+	Register(Rule{
+		ID:              "near-duplicate-names",
+		Description:     "Names and alternative names of different genres must not be nearly the same",
+		Hint:            "merge the genres if they are the same, otherwise suppress the finding with the reason they differ",
+		Category:        CategorySimilarity,
+		DefaultSeverity: SeverityWarning,
+		Options:         Options{nearDuplicateThresholdOption: defaultNearDuplicateThreshold},
+		Check:           checkNearDuplicateNames,
+	})
+}
+
+func checkNearDuplicateNames(dataset *Dataset, options Options) []Finding {
+	return ValidateNearDuplicateNames(dataset.Genres, options.Float(nearDuplicateThresholdOption))
+}
+
+// ValidateNearDuplicateNames checks if names and alternative names of different genres are nearly the same, by
+// their normalized Damerau-Levenshtein distance (see editdistance.Normalized).
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//	threshold: The highest normalized edit distance, from 0 to 1, at which two values are reported
+//
+// Returns:
+//
+//	[]Finding: A finding for every value that is nearly the same as a value of an earlier genre, or nil if none found
+//
+// Examples:
+//
+//	genres := []data.GameGenre{
+//	    {Name: "text adventure", AltNames: []string{}},
+//	    {Name: "text adventures", AltNames: []string{"interactive fiction"}},
+//	}
+//
+//	ValidateNearDuplicateNames(genres, 0.1)   // returns a finding for the name of "text adventures"
+//	ValidateNearDuplicateNames(genres, 0.05)  // returns nil, the names are 0.067 apart
+//
+// Note:
+//
+//	Equal values are not reported, they are the collisions found by the uniqueness and collision rules.
+//	Values of the same genre, or of genres with the same name, are not compared, since such genres are already
+//	reported as duplicates.
+//	Every pair is reported once, on the value that comes later in the file, and a value is reported at most once for
+//	every other genre, with the closest value of that genre.
+//	The findings are in file order.
+func ValidateNearDuplicateNames(genres []data.GameGenre, threshold float64) []Finding {
+	var distinctValues []string
+
+	occurrencesByValue := make(map[string][]namedValue)
+
+	for _, value := range namedValuesOf(genres) {
+		if _, ok := occurrencesByValue[value.value]; !ok {
+			distinctValues = append(distinctValues, value.value)
+		}
+
+		occurrencesByValue[value.value] = append(occurrencesByValue[value.value], value)
+	}
+
+	closestPairs := make(map[nearDuplicateKey]nearDuplicate)
+
+	for _, similarPair := range editdistance.SimilarPairs(distinctValues, threshold) {
+		for _, value := range occurrencesByValue[distinctValues[similarPair.First]] {
+			for _, otherValue := range occurrencesByValue[distinctValues[similarPair.Second]] {
+				recordNearDuplicate(closestPairs, genres, value, otherValue, similarPair.Distance)
+			}
+		}
+	}
+
+	pairs := make([]nearDuplicate, 0, len(closestPairs))
+
+	for _, pair := range closestPairs {
+		pairs = append(pairs, pair)
+	}
+
+	slices.SortFunc(pairs, func(a, b nearDuplicate) int {
+		if a.reported != b.reported {
+			return compareOrder(a.reported, b.reported)
+		}
+
+		return compareOrder(a.other, b.other)
+	})
+
+	var findings []Finding
+
+	for _, pair := range pairs {
+		findings = append(findings, pair.finding(genres))
+	}
+
+	return findings
+}
+
+// nearDuplicateKey identifies the reported value of a nearDuplicate and the genre it is nearly the same as.
+type nearDuplicateKey struct {
+	genreIndex      int
+	altNameIndex    int
+	otherGenreIndex int
+}
+
+// nearDuplicate is a pair of nearly the same values, the later one is reported.
+type nearDuplicate struct {
+	reported namedValue
+	other    namedValue
+	distance float64
+}
+
+func (pair nearDuplicate) finding(genres []data.GameGenre) Finding {
+	return pair.reported.finding(genres, fmt.Sprintf("%s is nearly the same as %s (normalized edit distance %.2f)",
+		pair.reported.kind(), pair.other.describe(genres), pair.distance))
+}
+
+// recordNearDuplicate keeps two nearly the same values in closestPairs if they are the closest pair found so far
+// between the later value and the genre of the earlier one.
+func recordNearDuplicate(closestPairs map[nearDuplicateKey]nearDuplicate, genres []data.GameGenre, a namedValue,
+	b namedValue, distance float64) {
+	if genres[a.genreIndex].Name == genres[b.genreIndex].Name {
+		return
+	}
+
+	reported, other := a, b
+
+	if compareOrder(reported, other) < 0 {
+		reported, other = other, reported
+	}
+
+	key := nearDuplicateKey{
+		genreIndex:      reported.genreIndex,
+		altNameIndex:    reported.altNameIndex,
+		otherGenreIndex: other.genreIndex,
+	}
+	candidate := nearDuplicate{reported: reported, other: other, distance: distance}
+
+	if current, ok := closestPairs[key]; ok && !candidate.closerThan(current) {
+		return
+	}
+
+	closestPairs[key] = candidate
+}
+
+func (pair nearDuplicate) closerThan(other nearDuplicate) bool {
+	if pair.distance != other.distance {
+		return pair.distance < other.distance
+	}
+
+	return compareOrder(pair.other, other.other) < 0
+}
@@ -0,0 +1,126 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateNearDuplicateNames(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		threshold    float64
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			threshold:    defaultNearDuplicateThreshold,
+			wantMessages: nil,
+		},
+		{
+			name: "plural of a genre name",
+			genres: []data.GameGenre{
+				{Name: "text adventure", AltNames: []string{}},
+				{Name: "text adventures", AltNames: []string{"interactive fiction"}},
+			},
+			threshold: defaultNearDuplicateThreshold,
+			wantMessages: []string{
+				`genre #1 "text adventures": genre name is nearly the same as the name of genre "text adventure" ` +
+					`(normalized edit distance 0.07)`,
+			},
+		},
+		{
+			name: "typo in an alt name",
+			genres: []data.GameGenre{
+				{Name: "strategy", AltNames: []string{"turn-based strategy"}},
+				{Name: "tactics", AltNames: []string{"turn-based startegy"}},
+			},
+			threshold: defaultNearDuplicateThreshold,
+			wantMessages: []string{
+				`genre #1 "tactics", altNames[0] "turn-based startegy": alternative name is nearly the same as ` +
+					`alternative name "turn-based strategy" of genre "strategy" (normalized edit distance 0.05)`,
+			},
+		},
+		{
+			name: "closest value of the other genre reported once",
+			genres: []data.GameGenre{
+				{Name: "rpg", AltNames: []string{"role-playing game", "role-playing games"}},
+				{Name: "roleplaying", AltNames: []string{"roleplaying game"}},
+			},
+			threshold: 0.12,
+			wantMessages: []string{
+				`genre #1 "roleplaying", altNames[0] "roleplaying game": alternative name is nearly the same as ` +
+					`alternative name "role-playing game" of genre "rpg" (normalized edit distance 0.06)`,
+			},
+		},
+		{
+			name: "values of the same genre",
+			genres: []data.GameGenre{
+				{Name: "text adventure", AltNames: []string{"text adventures"}},
+			},
+			threshold:    defaultNearDuplicateThreshold,
+			wantMessages: nil,
+		},
+		{
+			name: "equal values are collisions",
+			genres: []data.GameGenre{
+				{Name: "action", AltNames: []string{"fighting game"}},
+				{Name: "beat 'em up", AltNames: []string{"fighting game"}},
+			},
+			threshold:    defaultNearDuplicateThreshold,
+			wantMessages: nil,
+		},
+		{
+			name: "genres with the same name",
+			genres: []data.GameGenre{
+				{Name: "text adventure", AltNames: []string{}},
+				{Name: "text adventure", AltNames: []string{"text adventures"}},
+			},
+			threshold:    defaultNearDuplicateThreshold,
+			wantMessages: nil,
+		},
+		{
+			name: "genres that share a word",
+			genres: []data.GameGenre{
+				{Name: "esports", AltNames: []string{}},
+				{Name: "sports", AltNames: []string{}},
+			},
+			threshold:    defaultNearDuplicateThreshold,
+			wantMessages: nil,
+		},
+		{
+			name: "higher threshold",
+			genres: []data.GameGenre{
+				{Name: "esports", AltNames: []string{}},
+				{Name: "sports", AltNames: []string{}},
+			},
+			threshold: 0.2,
+			wantMessages: []string{
+				`genre #1 "sports": genre name is nearly the same as the name of genre "esports" ` +
+					`(normalized edit distance 0.14)`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			gotMessages := messagesOf(ValidateNearDuplicateNames(test.genres, test.threshold))
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
+
+func BenchmarkValidateNearDuplicateNames(benchmarkRunner *testing.B) {
+	benchmarkValidator(benchmarkRunner, func(genres []data.GameGenre) []Finding {
+		return ValidateNearDuplicateNames(genres, defaultNearDuplicateThreshold)
+	})
+}
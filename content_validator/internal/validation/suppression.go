@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"fmt"
+)
+
+// UnusedSuppressionRuleID is the rule ID of the findings Run reports for suppressions that match no finding.
+const UnusedSuppressionRuleID = "unused-suppression"
+
+// Suppression is a known exception: it hides the findings of a rule for a specific genre.
+//
+// Fields:
+//
+//	RuleID: The ID of the rule whose findings are suppressed
+//	GenreName: The name of the genre whose findings are suppressed
+//	AltName: If not empty, only the findings about this alternative name of the genre are suppressed
+//	Reason: Why the exception is deliberate
+type Suppression struct {
+	RuleID    string
+	GenreName string
+	AltName   string
+	Reason    string
+}
+
+// Matches reports whether the suppression hides the finding.
+//
+// Parameters:
+//
+//	finding: The finding to check
+//
+// Returns:
+//
+//	bool: true if the finding was reported by the suppressed rule for the suppressed genre (and alternative name, if
+//	set), false otherwise
+func (suppression Suppression) Matches(finding Finding) bool {
+	if finding.RuleID != suppression.RuleID || finding.GenreName != suppression.GenreName {
+		return false
+	}
+
+	if suppression.AltName == "" {
+		return true
+	}
+
+	return finding.Field != NameField && finding.Value == suppression.AltName
+}
+
+func (suppression Suppression) String() string {
+	if suppression.AltName == "" {
+		return fmt.Sprintf("suppression of rule %q for genre %q", suppression.RuleID, suppression.GenreName)
+	}
+
+	return fmt.Sprintf("suppression of rule %q for genre %q and alternative name %q", suppression.RuleID,
+		suppression.GenreName, suppression.AltName)
+}
+
+func suppressFindings(findings []Finding, suppressions []Suppression, used []bool) []Finding {
+	var keptFindings []Finding
+
+	for _, finding := range findings {
+		suppressed := false
+
+		for suppressionIndex, suppression := range suppressions {
+			if suppression.Matches(finding) {
+				used[suppressionIndex] = true
+				suppressed = true
+			}
+		}
+
+		if !suppressed {
+			keptFindings = append(keptFindings, finding)
+		}
+	}
+
+	return keptFindings
+}
+
+func newUnusedSuppressionFinding(suppression Suppression) Finding {
+	return Finding{
+		RuleID:     UnusedSuppressionRuleID,
+		Severity:   SeverityWarning,
+		GenreIndex: -1,
+		GenreName:  suppression.GenreName,
+		Field:      "",
+		Value:      suppression.AltName,
+		Message:    fmt.Sprintf("%s no longer matches any finding and can be removed", suppression),
+		File:       "",
+		Position:   data.Position{Line: 0, Column: 0},
+	}
+}
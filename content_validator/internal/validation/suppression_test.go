@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestRunWithSuppressions(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{
+		{Name: "action", AltNames: []string{"fighting"}},
+		{Name: "beat 'em up", AltNames: []string{"brawler", "fighting"}},
+	}
+
+	tests := []struct {
+		name         string
+		suppressions []Suppression
+		wantFindings []string
+	}{
+		{
+			name:         "no suppressions",
+			suppressions: nil,
+			wantFindings: []string{"alt-name-collision action", "alt-name-collision beat 'em up"},
+		},
+		{
+			name: "genre suppression",
+			suppressions: []Suppression{
+				{RuleID: "alt-name-collision", GenreName: "action", AltName: "", Reason: "shared on purpose"},
+			},
+			wantFindings: []string{"alt-name-collision beat 'em up"},
+		},
+		{
+			name: "alt name suppressions",
+			suppressions: []Suppression{
+				{RuleID: "alt-name-collision", GenreName: "action", AltName: "fighting", Reason: "shared on purpose"},
+				{RuleID: "alt-name-collision", GenreName: "beat 'em up", AltName: "fighting", Reason: "shared on purpose"},
+			},
+			wantFindings: nil,
+		},
+		{
+			name: "stale suppressions",
+			suppressions: []Suppression{
+				{RuleID: "alt-name-collision", GenreName: "action", AltName: "brawler", Reason: "outdated"},
+				{RuleID: "name-case", GenreName: "action", AltName: "", Reason: "outdated"},
+			},
+			wantFindings: []string{
+				"alt-name-collision action",
+				"alt-name-collision beat 'em up",
+				"unused-suppression action",
+				"unused-suppression action",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			findings := Run(Rules(), genres, RunOptions{
+				FailFast:     false,
+				FailOn:       SeverityError,
+				Suppressions: test.suppressions,
+			})
+
+			var gotFindings []string
+
+			for _, finding := range findings {
+				gotFindings = append(gotFindings, finding.RuleID+" "+finding.GenreName)
+			}
+
+			if !reflect.DeepEqual(gotFindings, test.wantFindings) {
+				runner.Errorf("got findings %v, want %v", gotFindings, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestUnusedSuppressionOfDisabledRule(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	findings := Run(nil, []data.GameGenre{{Name: "action", AltNames: nil}}, RunOptions{
+		FailFast: false,
+		FailOn:   SeverityError,
+		Suppressions: []Suppression{
+			{RuleID: "name-case", GenreName: "action", AltName: "", Reason: "rule is not run"},
+		},
+	})
+
+	if len(findings) != 0 {
+		testRunner.Errorf("suppressions of rules that were not run must not be reported, got %v", findings)
+	}
+}
@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"content_validator/internal/confusable"
+	"content_validator/internal/data"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	Register(Rule{
+		ID:              "nfc-normalized",
+		Description:     "Names and alternative names must be in Unicode normalization form NFC",
+		Hint:            "replace the decomposed characters with their composed form",
+		Category:        CategoryUnicode,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateNFC),
+		Fix:             nfcValue,
+	})
+
+	Register(Rule{
+		ID:              "confusable-characters",
+		Description:     "Names and alternative names must not contain characters that are easily mistaken for others",
+		Hint:            "replace the characters with their ASCII lookalikes and remove the invisible ones",
+		Category:        CategoryUnicode,
+		DefaultSeverity: SeverityError,
+		Check:           ignoringOptions(ValidateConfusableCharacters),
+	})
+}
+
+func nfcValue(value string) (string, bool) {
+	return norm.NFC.String(value), true
+}
+
+// ValidateNFC checks if all names and alternative names of the game genres are in Unicode normalization form NFC,
+// so the same text is always encoded with the same code points.
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every value that is not in NFC, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "bishōjo", AltNames: []string{"bishōjo game"}},
+//	}
+//
+//	ValidateNFC(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "bisho\u0304jo", AltNames: []string{"bishōjo game"}},
+//	}
+//
+//	ValidateNFC(invalidGenres)
+//	// returns a finding for the name: "U+006F U+0304 should be U+014D"
+//
+// Note:
+//
+//	The message lists the code points of every part of the value that changes in NFC and of what it changes to.
+func ValidateNFC(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for _, value := range namedValuesOf(genres) {
+		if norm.NFC.IsNormalString(value.value) {
+			continue
+		}
+
+		findings = append(findings, value.finding(genres, fmt.Sprintf("%s is not in Unicode normalization form NFC: %s",
+			value.kind(), strings.Join(denormalizedSegments(value.value), ", "))))
+	}
+
+	return findings
+}
+
+// denormalizedSegments describes the segments of the value that are not in NFC, e.g. "U+006F U+0304 should be
+// U+014D". Segments are the parts of the value that normalization never crosses, so they can be normalized alone.
+func denormalizedSegments(value string) []string {
+	var segments []string
+
+	for rest := value; rest != ""; {
+		segmentLength := norm.NFC.NextBoundaryInString(rest, true)
+		segment := rest[:segmentLength]
+		rest = rest[segmentLength:]
+
+		if norm.NFC.IsNormalString(segment) {
+			continue
+		}
+
+		segments = append(segments, fmt.Sprintf("%s should be %s", codePoints(segment),
+			codePoints(norm.NFC.String(segment))))
+	}
+
+	return segments
+}
+
+// codePoints formats the code points of a string, e.g. "U+006F U+0304".
+func codePoints(value string) string {
+	var codePointsOfValue []string
+
+	for _, character := range value {
+		codePointsOfValue = append(codePointsOfValue, fmt.Sprintf("U+%04X", character))
+	}
+
+	return strings.Join(codePointsOfValue, " ")
+}
+
+// ValidateConfusableCharacters checks if any name or alternative name of the game genres contains characters that
+// are easily mistaken for others: look-alikes of ASCII punctuation and spaces (curly apostrophes, non-breaking
+// spaces), invisible characters (zero-width spaces), and letters of another script than the rest of the value that
+// look like ASCII letters (a Cyrillic "а" in a Latin name).
+//
+// Parameters:
+//
+//	genres: A slice of data.GameGenre objects to validate
+//
+// Returns:
+//
+//	[]Finding: A finding for every value with confusable characters, or nil if none found
+//
+// Examples:
+//
+//	validGenres := []data.GameGenre{
+//	    {Name: "beat 'em up", AltNames: []string{"bishōjo"}},
+//	}
+//
+//	ValidateConfusableCharacters(validGenres)  // returns nil
+//
+//	invalidGenres := []data.GameGenre{
+//	    {Name: "beat ’em up", AltNames: []string{"brawler"}},
+//	}
+//
+//	ValidateConfusableCharacters(invalidGenres)
+//	// returns a finding for the name: `U+2019 RIGHT SINGLE QUOTATION MARK looks like "'"`
+//
+// Note:
+//
+//	The message lists every distinct confusable character of the value with its code point and Unicode name (see
+//	confusable.Find).
+//	The rule has no fix, since a look-alike may be intended and the right replacement needs a human decision.
+func ValidateConfusableCharacters(genres []data.GameGenre) []Finding {
+	var findings []Finding
+
+	for _, value := range namedValuesOf(genres) {
+		characters := confusable.Find(value.value)
+
+		if len(characters) == 0 {
+			continue
+		}
+
+		descriptions := make([]string, len(characters))
+
+		for characterIndex, character := range characters {
+			descriptions[characterIndex] = character.String()
+		}
+
+		findings = append(findings, value.finding(genres, fmt.Sprintf("%s contains confusable characters: %s",
+			value.kind(), strings.Join(descriptions, ", "))))
+	}
+
+	return findings
+}
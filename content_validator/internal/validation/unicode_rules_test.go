@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestValidateNFC(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantMessages: nil,
+		},
+		{
+			name: "composed characters",
+			genres: []data.GameGenre{
+				{Name: "bishōjo", AltNames: []string{"bishōjo game"}},
+				{Name: "beat 'em up", AltNames: nil},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "decomposed characters",
+			genres: []data.GameGenre{
+				{Name: "bisho\u0304jo", AltNames: []string{"bishōjo game", "bisho\u0304jo\u0301 game"}},
+			},
+			wantMessages: []string{
+				"name: genre name is not in Unicode normalization form NFC: U+006F U+0304 should be U+014D",
+				"altNames[1]: alternative name is not in Unicode normalization form NFC: U+006F U+0304 should be " +
+					"U+014D, U+006F U+0301 should be U+00F3",
+			},
+		},
+		{
+			name: "combining marks in non-canonical order",
+			genres: []data.GameGenre{
+				{Name: "a\u0301\u0323", AltNames: nil},
+			},
+			wantMessages: []string{
+				"name: genre name is not in Unicode normalization form NFC: U+0061 U+0301 U+0323 should be " +
+					"U+1EA1 U+0301",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			var gotMessages []string
+
+			for _, finding := range ValidateNFC(test.genres) {
+				gotMessages = append(gotMessages, finding.Field+": "+finding.Message)
+			}
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
+
+func TestValidateConfusableCharacters(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	tests := []struct {
+		name         string
+		genres       []data.GameGenre
+		wantMessages []string
+	}{
+		{
+			name:         "empty input",
+			genres:       []data.GameGenre{},
+			wantMessages: nil,
+		},
+		{
+			name: "plain characters",
+			genres: []data.GameGenre{
+				{Name: "beat 'em up", AltNames: []string{"brawler"}},
+				{Name: "bishōjo", AltNames: []string{"bishōjo game"}},
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "confusable punctuation and spaces",
+			genres: []data.GameGenre{
+				{Name: "beat ’em\u00A0up", AltNames: []string{"brawler", "beat-’em-up"}},
+			},
+			wantMessages: []string{
+				`name: genre name contains confusable characters: U+2019 RIGHT SINGLE QUOTATION MARK looks like ` +
+					`"'", U+00A0 NO-BREAK SPACE looks like " "`,
+				`altNames[1]: alternative name contains confusable characters: U+2019 RIGHT SINGLE QUOTATION ` +
+					`MARK looks like "'"`,
+			},
+		},
+		{
+			name: "invisible characters",
+			genres: []data.GameGenre{
+				{Name: "run\u200B-n-gun", AltNames: nil},
+			},
+			wantMessages: []string{
+				"name: genre name contains confusable characters: U+200B ZERO WIDTH SPACE is invisible",
+			},
+		},
+		{
+			name: "mixed scripts",
+			genres: []data.GameGenre{
+				{Name: "аction", AltNames: []string{"λ calculus", "美少女ゲーム"}},
+			},
+			wantMessages: []string{
+				`name: genre name contains confusable characters: U+0430 CYRILLIC SMALL LETTER A is a Cyrillic ` +
+					`letter among Latin letters and looks like "a"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			var gotMessages []string
+
+			for _, finding := range ValidateConfusableCharacters(test.genres) {
+				gotMessages = append(gotMessages, finding.Field+": "+finding.Message)
+			}
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got messages %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package writer
+
+import (
+	"bytes"
+	"content_validator/internal/data"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+var errNoStringValue = errors.New("no string value")
+
+// ValueEdit is a correction of a single string value of a game genres file.
+//
+// Fields:
+//
+//	Position: The location of the opening quote of the value, as recorded in data.GenrePositions
+//	Value: The new value, ignored if Remove is set
+//	Remove: Whether the value is removed from its array rather than replaced
+type ValueEdit struct {
+	Position data.Position
+	Value    string
+	Remove   bool
+}
+
+// EditValues replaces or removes string values of a game genres file, leaving the rest of its content as is.
+//
+// Parameters:
+//
+//	content: The content of the file
+//	edits: The corrections to make, at most one per value
+//
+// Returns:
+//
+//	[]byte: The content with the values corrected
+//	error: An error if an edit does not point to a string value of the content
+//
+// Examples:
+//
+//	content := []byte(`[{"name": "Action", "altNames": ["fps", "shooter"]}]`)
+//
+//	EditValues(content, []ValueEdit{
+//	    {Position: data.Position{Line: 1, Column: 11}, Value: "action", Remove: false},
+//	    {Position: data.Position{Line: 1, Column: 41}, Value: "", Remove: true},
+//	})
+//	// returns `[{"name": "action", "altNames": ["fps"]}]`
+//
+// Note:
+//
+//	A removed value is removed with the comma that separates it from the value before it, or else from the value
+//	after it, so the layout of the remaining values is kept. The new values are written with non-ASCII characters
+//	as is, like EncodeGameGenres does.
+//
+// Errors:
+//
+//   - Returns "no string value: line [line], column [column]" if an edit does not point to a string value
+func EditValues(content []byte, edits []ValueEdit) ([]byte, error) {
+	lineStarts := []int{0}
+
+	for offset, character := range content {
+		if character == '\n' {
+			lineStarts = append(lineStarts, offset+1)
+		}
+	}
+
+	type offsetEdit struct {
+		start int
+		edit  ValueEdit
+	}
+
+	offsetEdits := make([]offsetEdit, 0, len(edits))
+
+	for _, edit := range edits {
+		start, ok := offsetOf(content, lineStarts, edit.Position)
+
+		if !ok || content[start] != '"' {
+			return nil, fmt.Errorf("%w: line %d, column %d", errNoStringValue, edit.Position.Line,
+				edit.Position.Column)
+		}
+
+		offsetEdits = append(offsetEdits, offsetEdit{start: start, edit: edit})
+	}
+
+	// NOTE: Editing from the end keeps the offsets of the values that are still to be edited.
+	slices.SortFunc(offsetEdits, func(a, b offsetEdit) int { return b.start - a.start })
+
+	editedContent := slices.Clone(content)
+
+	for _, offsetEdit := range offsetEdits {
+		end, ok := stringEnd(editedContent, offsetEdit.start)
+
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d, column %d", errNoStringValue, offsetEdit.edit.Position.Line,
+				offsetEdit.edit.Position.Column)
+		}
+
+		if offsetEdit.edit.Remove {
+			removalStart, removalEnd := removalBounds(editedContent, offsetEdit.start, end)
+			editedContent = slices.Delete(editedContent, removalStart, removalEnd)
+
+			continue
+		}
+
+		value, err := encodeString(offsetEdit.edit.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		editedContent = slices.Replace(editedContent, offsetEdit.start, end, value...)
+	}
+
+	return editedContent, nil
+}
+
+// offsetOf returns the byte offset of a position in the content, or false if the content has no such position.
+func offsetOf(content []byte, lineStarts []int, position data.Position) (int, bool) {
+	if position.Line < 1 || position.Line > len(lineStarts) || position.Column < 1 {
+		return 0, false
+	}
+
+	offset := lineStarts[position.Line-1]
+
+	for range position.Column - 1 {
+		if offset >= len(content) || content[offset] == '\n' {
+			return 0, false
+		}
+
+		_, size := utf8.DecodeRune(content[offset:])
+		offset += size
+	}
+
+	return offset, offset < len(content)
+}
+
+// stringEnd returns the offset right after the closing quote of the JSON string starting at start, or false if the
+// string is not closed.
+func stringEnd(content []byte, start int) (int, bool) {
+	for offset := start + 1; offset < len(content); offset++ {
+		switch content[offset] {
+		case '\\':
+			offset++
+		case '"':
+			return offset + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+// removalBounds returns the part of the content to delete to remove the array element from start to end, with the
+// comma before it, or else with the comma after it, or else everything between the brackets of the array.
+func removalBounds(content []byte, start int, end int) (int, int) {
+	before := start - 1
+
+	for before >= 0 && isJSONSpace(content[before]) {
+		before--
+	}
+
+	if before >= 0 && content[before] == ',' {
+		return before, end
+	}
+
+	after := end
+
+	for after < len(content) && isJSONSpace(content[after]) {
+		after++
+	}
+
+	if after < len(content) && content[after] == ',' {
+		after++
+
+		for after < len(content) && isJSONSpace(content[after]) {
+			after++
+		}
+
+		return start, after
+	}
+
+	return before + 1, after
+}
+
+// isJSONSpace reports whether the character is whitespace between JSON tokens.
+func isJSONSpace(character byte) bool {
+	return character == ' ' || character == '\t' || character == '\n' || character == '\r'
+}
+
+// encodeString returns the JSON string literal of a value, with non-ASCII characters written as is.
+func encodeString(value string) ([]byte, error) {
+	var literal bytes.Buffer
+
+	encoder := json.NewEncoder(&literal)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Encode always ends the value with a newline.
+	return bytes.TrimSuffix(literal.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,115 @@
+package writer
+
+import (
+	"content_validator/internal/data"
+	"errors"
+	"testing"
+)
+
+func TestEditValues(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	content := "[\n\t{\n\t\t\"name\": \"Action\",\n\t\t\"altNames\": [\n\t\t\t\"Fps\",\n\t\t\t\"shooter\",\n" +
+		"\t\t\t\"tps\"\n\t\t]\n\t}\n]\n"
+
+	tests := []struct {
+		name    string
+		content string
+		edits   []ValueEdit
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "no edits",
+			content: content,
+			edits:   nil,
+			want:    content,
+			wantErr: nil,
+		},
+		{
+			name:    "replace values",
+			content: content,
+			edits: []ValueEdit{
+				{Position: data.Position{Line: 3, Column: 11}, Value: "action", Remove: false},
+				{Position: data.Position{Line: 5, Column: 4}, Value: "fps", Remove: false},
+			},
+			want: "[\n\t{\n\t\t\"name\": \"action\",\n\t\t\"altNames\": [\n\t\t\t\"fps\",\n\t\t\t\"shooter\",\n" +
+				"\t\t\t\"tps\"\n\t\t]\n\t}\n]\n",
+			wantErr: nil,
+		},
+		{
+			name:    "remove the first value",
+			content: content,
+			edits:   []ValueEdit{{Position: data.Position{Line: 5, Column: 4}, Value: "", Remove: true}},
+			want: "[\n\t{\n\t\t\"name\": \"Action\",\n\t\t\"altNames\": [\n\t\t\t\"shooter\",\n\t\t\t\"tps\"\n\t\t]\n" +
+				"\t}\n]\n",
+			wantErr: nil,
+		},
+		{
+			name:    "remove the last value",
+			content: content,
+			edits:   []ValueEdit{{Position: data.Position{Line: 7, Column: 4}, Value: "", Remove: true}},
+			want: "[\n\t{\n\t\t\"name\": \"Action\",\n\t\t\"altNames\": [\n\t\t\t\"Fps\",\n\t\t\t\"shooter\"\n\t\t]\n" +
+				"\t}\n]\n",
+			wantErr: nil,
+		},
+		{
+			name:    "remove every value",
+			content: content,
+			edits: []ValueEdit{
+				{Position: data.Position{Line: 5, Column: 4}, Value: "", Remove: true},
+				{Position: data.Position{Line: 6, Column: 4}, Value: "", Remove: true},
+				{Position: data.Position{Line: 7, Column: 4}, Value: "", Remove: true},
+			},
+			want:    "[\n\t{\n\t\t\"name\": \"Action\",\n\t\t\"altNames\": []\n\t}\n]\n",
+			wantErr: nil,
+		},
+		{
+			name:    "columns count characters",
+			content: `[{"name": "ō", "altNames": ["Ōx", "ōx"]}]`,
+			edits: []ValueEdit{
+				{Position: data.Position{Line: 1, Column: 29}, Value: "ōx", Remove: false},
+				{Position: data.Position{Line: 1, Column: 35}, Value: "", Remove: true},
+			},
+			want:    `[{"name": "ō", "altNames": ["ōx"]}]`,
+			wantErr: nil,
+		},
+		{
+			name:    "escaped characters",
+			content: `[{"name": "\"beat\" 'em up", "altNames": []}]`,
+			edits:   []ValueEdit{{Position: data.Position{Line: 1, Column: 11}, Value: `beat <'em> up`, Remove: false}},
+			want:    `[{"name": "beat <'em> up", "altNames": []}]`,
+			wantErr: nil,
+		},
+		{
+			name:    "not a string value",
+			content: content,
+			edits:   []ValueEdit{{Position: data.Position{Line: 2, Column: 2}, Value: "action", Remove: false}},
+			want:    "",
+			wantErr: errNoStringValue,
+		},
+		{
+			name:    "position outside of the content",
+			content: content,
+			edits:   []ValueEdit{{Position: data.Position{Line: 3, Column: 40}, Value: "action", Remove: false}},
+			want:    "",
+			wantErr: errNoStringValue,
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			got, err := EditValues([]byte(test.content), test.edits)
+
+			if !errors.Is(err, test.wantErr) {
+				runner.Fatalf("EditValues() error = %v, want %v", err, test.wantErr)
+			}
+
+			if string(got) != test.want {
+				runner.Errorf("EditValues() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
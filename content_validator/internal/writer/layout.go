@@ -0,0 +1,122 @@
+package writer
+
+import (
+	"bytes"
+	"content_validator/internal/collation"
+	"content_validator/internal/data"
+	"fmt"
+	"slices"
+)
+
+// LayoutProblem is a difference between a game genres file and its canonical layout.
+//
+// Fields:
+//
+//	Position: The location of the problem in the file, the zero value if it concerns the whole file
+//	Message: A human-readable explanation of the problem
+type LayoutProblem struct {
+	Position data.Position
+	Message  string
+}
+
+// CheckLayout lists the differences between the document and its canonical layout, see Canonical and
+// EncodeGameGenres.
+//
+// Parameters:
+//
+//	document: The document to check
+//
+// Returns:
+//
+//	[]LayoutProblem: The problems in file order, followed by the problems of the whole file, or nil if the document
+//	is in the canonical layout
+//	error: An error if the genres cannot be serialized
+//
+// Examples:
+//
+//	problems, err := CheckLayout(document)
+//
+//	for _, problem := range problems {
+//	    fmt.Printf("%s:%d:%d: %s\n", document.Path, problem.Position.Line, problem.Position.Column, problem.Message)
+//	}
+//
+// Note:
+//
+//	Problems of single genres (order, unsorted or missing alternative names) are reported first. The problems of
+//	the whole file (indentation, spacing, trailing newline) are only reported if there are no problems of genres,
+//	since reordering the genres changes the whole file anyway.
+func CheckLayout(document data.Document) ([]LayoutProblem, error) {
+	canonicalContent, err := EncodeGameGenres(Canonical(document.Genres), true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(document.Content, canonicalContent) {
+		return nil, nil
+	}
+
+	problems := genreLayoutProblems(document)
+
+	if len(problems) > 0 {
+		return problems, nil
+	}
+
+	if !HasTrailingNewline(document.Content) {
+		problems = append(problems, LayoutProblem{
+			Position: data.Position{Line: 0, Column: 0},
+			Message:  "file does not end with a newline",
+		})
+	}
+
+	content, err := EncodeGameGenres(document.Genres, HasTrailingNewline(document.Content))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(document.Content, content) {
+		problems = append(problems, LayoutProblem{
+			Position: data.Position{Line: 0, Column: 0},
+			Message:  "file is not laid out with tab indentation and one value per line",
+		})
+	}
+
+	return problems, nil
+}
+
+func genreLayoutProblems(document data.Document) []LayoutProblem {
+	var problems []LayoutProblem
+
+	for genreIndex, genre := range document.Genres {
+		position := data.Position{Line: 0, Column: 0}
+
+		if genreIndex < len(document.Positions) {
+			position = document.Positions[genreIndex].Genre
+		}
+
+		if genreIndex > 0 && collation.Compare(document.Genres[genreIndex-1].Name, genre.Name) > 0 {
+			problems = append(problems, LayoutProblem{
+				Position: position,
+				Message: fmt.Sprintf("genre #%d %q must be sorted before genre #%d %q", genreIndex, genre.Name,
+					genreIndex-1, document.Genres[genreIndex-1].Name),
+			})
+		}
+
+		if genre.AltNames == nil {
+			problems = append(problems, LayoutProblem{
+				Position: position,
+				Message:  fmt.Sprintf("genre #%d %q has no altNames list, expected []", genreIndex, genre.Name),
+			})
+		}
+
+		if !slices.IsSortedFunc(genre.AltNames, collation.Compare) {
+			problems = append(problems, LayoutProblem{
+				Position: position,
+				Message:  fmt.Sprintf("alternative names of genre #%d %q are not sorted", genreIndex, genre.Name),
+			})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,89 @@
+package writer
+
+import (
+	"content_validator/internal/data"
+	"reflect"
+	"testing"
+)
+
+func TestCheckLayout(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	canonicalContent := "[\n\t{\n\t\t\"name\": \"action\",\n\t\t\"altNames\": []\n\t}\n]\n"
+
+	tests := []struct {
+		name         string
+		document     data.Document
+		wantMessages []string
+	}{
+		{
+			name: "canonical",
+			document: data.Document{
+				Path:      "genres.json",
+				Content:   []byte(canonicalContent),
+				Genres:    []data.GameGenre{{Name: "action", AltNames: []string{}}},
+				Positions: nil,
+			},
+			wantMessages: nil,
+		},
+		{
+			name: "out of order genres and alt names",
+			document: data.Document{
+				Path:    "genres.json",
+				Content: []byte("[]"),
+				Genres: []data.GameGenre{
+					{Name: "racing", AltNames: []string{"racing game", "kart racing"}},
+					{Name: "action", AltNames: nil},
+				},
+				Positions: nil,
+			},
+			wantMessages: []string{
+				`alternative names of genre #0 "racing" are not sorted`,
+				`genre #1 "action" must be sorted before genre #0 "racing"`,
+				`genre #1 "action" has no altNames list, expected []`,
+			},
+		},
+		{
+			name: "missing trailing newline",
+			document: data.Document{
+				Path:      "genres.json",
+				Content:   []byte(canonicalContent[:len(canonicalContent)-1]),
+				Genres:    []data.GameGenre{{Name: "action", AltNames: []string{}}},
+				Positions: nil,
+			},
+			wantMessages: []string{"file does not end with a newline"},
+		},
+		{
+			name: "spaces instead of tabs",
+			document: data.Document{
+				Path:      "genres.json",
+				Content:   []byte("[\n  {\n    \"name\": \"action\",\n    \"altNames\": []\n  }\n]\n"),
+				Genres:    []data.GameGenre{{Name: "action", AltNames: []string{}}},
+				Positions: nil,
+			},
+			wantMessages: []string{"file is not laid out with tab indentation and one value per line"},
+		},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			problems, err := CheckLayout(test.document)
+
+			if err != nil {
+				runner.Fatalf("unexpected error: %v", err)
+			}
+
+			var gotMessages []string
+
+			for _, problem := range problems {
+				gotMessages = append(gotMessages, problem.Message)
+			}
+
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				runner.Errorf("got %q, want %q", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package writer
+
+import (
+	"bytes"
+	"content_validator/internal/collation"
+	"content_validator/internal/data"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// jsonIndent is the indentation of the game genres files.
+const jsonIndent = "\t"
+
+var errWriteFile = errors.New("error writing file")
+
+// EncodeGameGenres serializes game genres in the layout of the game genres files: an array of objects indented with
+// tabs, with non-ASCII characters written as is.
+//
+// Parameters:
+//
+//	genres: The game genres to serialize
+//	trailingNewline: Whether the result must end with a newline
+//
+// Returns:
+//
+//	[]byte: The serialized genres
+//	error: An error if the genres cannot be serialized
+//
+// Examples:
+//
+//	content, err := EncodeGameGenres([]data.GameGenre{{Name: "action", AltNames: []string{}}}, false)
+//	// content is "[\n\t{\n\t\t\"name\": \"action\",\n\t\t\"altNames\": []\n\t}\n]"
+//
+// Note:
+//
+//	Nil AltNames are written as [], like empty ones, so a genre always has an alternative names list.
+func EncodeGameGenres(genres []data.GameGenre, trailingNewline bool) ([]byte, error) {
+	var content bytes.Buffer
+
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", jsonIndent)
+
+	err := encoder.Encode(withAltNamesLists(genres))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Encode always ends the value with a newline.
+	if !trailingNewline {
+		return bytes.TrimSuffix(content.Bytes(), []byte("\n")), nil
+	}
+
+	return content.Bytes(), nil
+}
+
+// withAltNamesLists returns the genres with nil AltNames replaced by empty slices, copying the genres only if needed.
+func withAltNamesLists(genres []data.GameGenre) []data.GameGenre {
+	if !slices.ContainsFunc(genres, func(genre data.GameGenre) bool { return genre.AltNames == nil }) {
+		return genres
+	}
+
+	genresWithLists := slices.Clone(genres)
+
+	for genreIndex, genre := range genresWithLists {
+		if genre.AltNames == nil {
+			genresWithLists[genreIndex].AltNames = []string{}
+		}
+	}
+
+	return genresWithLists
+}
+
+// Canonical returns the genres in the canonical order of the game genres files: genres sorted by name and
+// alternative names sorted, both in the order of collation.Compare, with missing alternative names lists replaced by
+// empty ones (written as []).
+//
+// Parameters:
+//
+//	genres: The game genres to put in order, they are not modified
+//
+// Returns:
+//
+//	[]data.GameGenre: A sorted copy of the genres. Genres with the same name keep their relative order.
+//
+// Examples:
+//
+//	Canonical([]data.GameGenre{
+//	    {Name: "racing", AltNames: []string{"racing game", "kart racing"}},
+//	    {Name: "action", AltNames: nil},
+//	})
+//	// returns []data.GameGenre{
+//	//     {Name: "action", AltNames: []string{}},
+//	//     {Name: "racing", AltNames: []string{"kart racing", "racing game"}},
+//	// }
+func Canonical(genres []data.GameGenre) []data.GameGenre {
+	canonicalGenres := make([]data.GameGenre, 0, len(genres))
+
+	for _, genre := range genres {
+		altNames := make([]string, len(genre.AltNames))
+		copy(altNames, genre.AltNames)
+		collation.Sort(altNames)
+
+		canonicalGenres = append(canonicalGenres, data.GameGenre{Name: genre.Name, AltNames: altNames})
+	}
+
+	slices.SortStableFunc(canonicalGenres, func(a, b data.GameGenre) int {
+		return collation.Compare(a.Name, b.Name)
+	})
+
+	return canonicalGenres
+}
+
+// HasTrailingNewline reports whether the content ends with a newline, so rewritten files can keep their ending.
+func HasTrailingNewline(content []byte) bool {
+	return bytes.HasSuffix(content, []byte("\n"))
+}
+
+// ReplaceFileContent replaces the content of an existing file, keeping the file's permissions.
+//
+// Parameters:
+//
+//	filePath: The path to the file to rewrite
+//	content: The new content of the file
+//
+// Returns:
+//
+//	error: An error if the file does not exist or cannot be written
+//
+// Errors:
+//
+//   - Returns "error writing file: [underlying error]" if the file cannot be written
+func ReplaceFileContent(filePath string, content []byte) error {
+	fileInfo, err := os.Stat(filePath)
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWriteFile, err)
+	}
+
+	err = os.WriteFile(filePath, content, fileInfo.Mode().Perm())
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWriteFile, err)
+	}
+
+	return nil
+}
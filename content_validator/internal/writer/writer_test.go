@@ -0,0 +1,176 @@
+package writer
+
+import (
+	"content_validator/internal/data"
+	"content_validator/internal/reader"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeGameGenres(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{
+		{Name: "action", AltNames: []string{"jeu d'été", "<fighting> & co"}},
+		{Name: "racing", AltNames: []string{}},
+	}
+
+	want := "[\n" +
+		"\t{\n" +
+		"\t\t\"name\": \"action\",\n" +
+		"\t\t\"altNames\": [\n" +
+		"\t\t\t\"jeu d'été\",\n" +
+		"\t\t\t\"<fighting> & co\"\n" +
+		"\t\t]\n" +
+		"\t},\n" +
+		"\t{\n" +
+		"\t\t\"name\": \"racing\",\n" +
+		"\t\t\"altNames\": []\n" +
+		"\t}\n" +
+		"]"
+
+	tests := []struct {
+		name            string
+		trailingNewline bool
+		want            string
+	}{
+		{name: "without trailing newline", trailingNewline: false, want: want},
+		{name: "with trailing newline", trailingNewline: true, want: want + "\n"},
+	}
+
+	for _, test := range tests {
+		testRunner.Run(test.name, func(runner *testing.T) {
+			runner.Parallel()
+
+			content, err := EncodeGameGenres(genres, test.trailingNewline)
+
+			if err != nil {
+				runner.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(content) != test.want {
+				runner.Errorf("got %q, want %q", content, test.want)
+			}
+
+			if HasTrailingNewline(content) != test.trailingNewline {
+				runner.Errorf("got trailing newline %v, want %v", HasTrailingNewline(content), test.trailingNewline)
+			}
+		})
+	}
+}
+
+func TestReplaceFileContent(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	const fileMode = 0o640
+
+	jsonFilePath := filepath.Join(testRunner.TempDir(), "genres.json")
+
+	err := os.WriteFile(jsonFilePath, []byte("[]"), fileMode)
+
+	if err != nil {
+		testRunner.Fatalf("failed to write test file: %v", err)
+	}
+
+	err = ReplaceFileContent(jsonFilePath, []byte("[]\n"))
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	fileInfo, err := os.Stat(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to stat test file: %v", err)
+	}
+
+	if fileInfo.Mode().Perm() != fileMode {
+		testRunner.Errorf("got file mode %v, want %v", fileInfo.Mode().Perm(), os.FileMode(fileMode))
+	}
+
+	content, err := os.ReadFile(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to read test file: %v", err)
+	}
+
+	if string(content) != "[]\n" {
+		testRunner.Errorf("got content %q, want %q", content, "[]\n")
+	}
+
+	err = ReplaceFileContent(filepath.Join(testRunner.TempDir(), "missing", "genres.json"), []byte("[]"))
+
+	if err == nil {
+		testRunner.Error("got no error for a file in a missing directory")
+	}
+}
+
+func TestEncodeGameGenresRoundTrip(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{
+		{Name: "action", AltNames: nil},
+		{Name: "racing", AltNames: []string{}},
+		{Name: "shooter", AltNames: []string{"fps"}},
+	}
+
+	want := []data.GameGenre{
+		{Name: "action", AltNames: []string{}},
+		{Name: "racing", AltNames: []string{}},
+		{Name: "shooter", AltNames: []string{"fps"}},
+	}
+
+	content, err := EncodeGameGenres(genres, true)
+
+	if err != nil {
+		testRunner.Fatalf("unexpected error: %v", err)
+	}
+
+	jsonFilePath := filepath.Join(testRunner.TempDir(), "genres.json")
+
+	err = os.WriteFile(jsonFilePath, content, 0o600)
+
+	if err != nil {
+		testRunner.Fatalf("failed to write test file: %v", err)
+	}
+
+	gotGenres, err := reader.ReadGameGenresFromJSON(jsonFilePath)
+
+	if err != nil {
+		testRunner.Fatalf("failed to read written genres: %v", err)
+	}
+
+	if !reflect.DeepEqual(gotGenres, want) {
+		testRunner.Errorf("got genres %+v, want %+v", gotGenres, want)
+	}
+
+	if genres[0].AltNames != nil {
+		testRunner.Errorf("EncodeGameGenres modified its input: %+v", genres)
+	}
+}
+
+func TestCanonical(testRunner *testing.T) {
+	testRunner.Parallel()
+
+	genres := []data.GameGenre{
+		{Name: "racing", AltNames: []string{"racing game", "kart racing"}},
+		{Name: "bishōjo", AltNames: []string{"bishoujo", "bishojo"}},
+		{Name: "action", AltNames: nil},
+	}
+
+	want := []data.GameGenre{
+		{Name: "action", AltNames: []string{}},
+		{Name: "bishōjo", AltNames: []string{"bishojo", "bishoujo"}},
+		{Name: "racing", AltNames: []string{"kart racing", "racing game"}},
+	}
+
+	if got := Canonical(genres); !reflect.DeepEqual(got, want) {
+		testRunner.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if genres[0].AltNames[0] != "racing game" {
+		testRunner.Errorf("Canonical modified its input: %+v", genres)
+	}
+}